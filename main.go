@@ -6,6 +6,7 @@ import (
 	"api-i18n/main/src/database"
 	"api-i18n/main/src/middleware"
 	"api-i18n/main/src/routes"
+	"api-i18n/main/src/storage"
 	"fmt"
 	"os"
 
@@ -35,6 +36,11 @@ func main() {
 	}
 	defer cache.Valkey.Close()
 
+	// Open file storage.
+	if err := storage.OpenStorage(); err != nil {
+		panic(fmt.Sprintf("Could not open the file storage: %v", err))
+	}
+
 	// Register a private routes_util for app.
 	routes.PrivateRoutes(app)
 	// Register a public routes_util for app.
@@ -14,10 +14,16 @@ import (
 func FiberConfig() fiber.Config {
 	// Define server settings.
 	readTimeoutSecondsCount, _ := strconv.Atoi(os.Getenv("SERVER_READ_TIMEOUT"))
+	bodyLimitMegabytes, err := strconv.Atoi(os.Getenv("SERVER_BODY_LIMIT"))
+	if err != nil || bodyLimitMegabytes <= 0 {
+		// Fits a batch of 50,000 phone numbers as JSON or CSV.
+		bodyLimitMegabytes = 16
+	}
 
 	// Return Fiber configuration.
 	return fiber.Config{
 		ReadTimeout:  time.Second * time.Duration(readTimeoutSecondsCount),
 		ErrorHandler: utils.ErrorHandler,
+		BodyLimit:    bodyLimitMegabytes * 1024 * 1024,
 	}
 }
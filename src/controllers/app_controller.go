@@ -7,6 +7,7 @@ import (
 	"api-i18n/main/src/models"
 	"api-i18n/main/src/services"
 	"api-i18n/main/src/utils"
+	"slices"
 
 	util "github.com/ArnoldPMolenaar/api-utils/utils"
 	"github.com/gofiber/fiber/v2"
@@ -52,9 +53,16 @@ func GetAppLocales(c *fiber.Ctx) error {
 		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
 	}
 
+	// Get the app source locale.
+	sourceLocaleID, err := services.GetAppSourceLocaleID(appNameParam)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
 	// Return the app.
 	response := responses.AppLocale{}
 	response.SetAppLocale(appNameParam, locales)
+	response.SetSourceLocale(sourceLocaleID)
 
 	return c.JSON(response)
 }
@@ -115,20 +123,34 @@ func SetAppLocales(c *fiber.Ctx) error {
 	}
 
 	for _, locale := range request.Locales {
-		id := utils.ResolveLocaleId(locale)
-		if id == nil {
+		id, err := utils.ResolveLocaleId(locale)
+		if err != nil {
+			return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+		} else if id == nil {
 			return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "Invalid locale: "+locale)
 		}
 	}
 
+	// Check if the source locale is one of the app locales.
+	if request.SourceLocaleID != nil && !slices.Contains(request.Locales, *request.SourceLocaleID) {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "Source locale must be one of the locales.")
+	}
+
 	// Set the app locale.
-	if err := services.SetAppLocales(appNameParam, request.Locales); err != nil {
+	if err := services.SetAppLocales(appNameParam, request.Locales, request.SourceLocaleID); err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	// Get the app source locale.
+	sourceLocaleID, err := services.GetAppSourceLocaleID(appNameParam)
+	if err != nil {
 		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
 	}
 
 	// Return the app.
 	response := responses.AppLocale{}
 	response.SetAppLocaleSimple(appNameParam, request.Locales)
+	response.SetSourceLocale(sourceLocaleID)
 
 	return c.JSON(response)
 }
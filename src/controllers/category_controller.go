@@ -5,6 +5,7 @@ import (
 	"api-i18n/main/src/dto/responses"
 	"api-i18n/main/src/errors"
 	"api-i18n/main/src/services"
+	"api-i18n/main/src/utils"
 
 	errorutil "github.com/ArnoldPMolenaar/api-utils/errors"
 	util "github.com/ArnoldPMolenaar/api-utils/utils"
@@ -21,7 +22,7 @@ func GetCategories(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(paginationModel)
 }
 
-// GetCategoryLookup func for getting category lookup.
+// GetCategoryLookup func for getting category lookup, sorted with the collation of the optional locale ID.
 func GetCategoryLookup(c *fiber.Ctx) error {
 	nameParam := c.Query("name")
 	var name *string
@@ -29,7 +30,19 @@ func GetCategoryLookup(c *fiber.Ctx) error {
 		name = &nameParam
 	}
 
-	categories, err := services.GetCategoryLookup(name)
+	// Without a locale, the root collation is used.
+	localeID := "und"
+	if localeIDParam := c.Query("localeId"); localeIDParam != "" {
+		resolvedLocaleId, err := utils.ResolveLocaleId(localeIDParam)
+		if err != nil {
+			return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+		} else if resolvedLocaleId == nil {
+			return errorutil.Response(c, fiber.StatusBadRequest, errors.LocaleNotFound, "Locale not found.")
+		}
+		localeID = *resolvedLocaleId
+	}
+
+	categories, err := services.GetCategoryLookup(localeID, name)
 	if err != nil {
 		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
 	}
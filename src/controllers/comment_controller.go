@@ -0,0 +1,219 @@
+package controllers
+
+import (
+	"api-i18n/main/src/dto/requests"
+	"api-i18n/main/src/dto/responses"
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/errors"
+	"api-i18n/main/src/models"
+	"api-i18n/main/src/services"
+	"strconv"
+	"strings"
+
+	errorutil "github.com/ArnoldPMolenaar/api-utils/errors"
+	util "github.com/ArnoldPMolenaar/api-utils/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+// headerUserID is the header with the ID of the user on whose behalf the machine credential acts.
+const headerUserID = "X-User-Id"
+
+// GetKeyComments func for getting the comment threads of a key.
+func GetKeyComments(c *fiber.Ctx) error {
+	keyID, err := util.StringToUint(c.Params("id"))
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, err.Error())
+	}
+
+	var localeID *string
+	if c.Query("localeId") != "" {
+		value := c.Query("localeId")
+		localeID = &value
+	}
+
+	var resolved *bool
+	if c.Query("resolved") != "" {
+		value, err := strconv.ParseBool(c.Query("resolved"))
+		if err != nil {
+			return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, err.Error())
+		}
+		resolved = &value
+	}
+
+	// Check if the key exists.
+	key, err := services.GetKeyByID(keyID)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if key.ID == 0 {
+		return errorutil.Response(c, fiber.StatusNotFound, errors.KeyExists, "Key does not exist.")
+	}
+
+	comments, err := services.GetKeyComments(key.ID, localeID, resolved)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	response := responses.KeyCommentList{}
+	response.SetKeyCommentList(comments)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// CreateKeyComment func for starting a comment thread on a key or replying to one.
+// The author is the user in the X-User-Id header.
+func CreateKeyComment(c *fiber.Ctx) error {
+	keyID, err := util.StringToUint(c.Params("id"))
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, err.Error())
+	}
+
+	author := strings.TrimSpace(c.Get(headerUserID))
+	if author == "" {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.MissingRequiredParam, headerUserID+" header is required.")
+	}
+
+	// Create a new comment struct for the request.
+	commentRequest := &requests.CreateKeyComment{}
+
+	// Check, if received JSON data is parsed.
+	if err := c.BodyParser(commentRequest); err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.BodyParse, err.Error())
+	}
+
+	// Validate comment fields.
+	validate := util.NewValidator()
+	if err := validate.Struct(commentRequest); err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.Validator, util.ValidatorErrors(err))
+	}
+
+	// Check if the key exists.
+	key, err := services.GetKeyByID(keyID)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if key.ID == 0 {
+		return errorutil.Response(c, fiber.StatusNotFound, errors.KeyExists, "Key does not exist.")
+	}
+
+	// Check if the thread of a reply exists.
+	var parent *models.KeyComment
+	if commentRequest.ParentID != nil {
+		if parent, err = services.GetKeyCommentByID(key.ID, *commentRequest.ParentID); err != nil {
+			return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+		} else if parent.ID == 0 || parent.ParentID.Valid {
+			return errorutil.Response(c, fiber.StatusBadRequest, errors.CommentExists, "Comment thread does not exist.")
+		}
+	} else if commentRequest.LocaleID != nil {
+		// Check if the locale is set in the app.
+		if valid, err := HasAppLocales(key.AppName, *commentRequest.LocaleID); err != nil {
+			return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+		} else if !valid {
+			return errorutil.Response(c, fiber.StatusBadRequest, errors.LocaleNotFound, "Locale not found.")
+		}
+	}
+
+	comment, err := services.CreateKeyComment(key.ID, author, *commentRequest, parent)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	response := responses.KeyComment{}
+	response.SetKeyComment(comment)
+
+	services.DispatchWebhookEvent(key.AppName, enums.COMMENT_CREATED, response)
+
+	return c.Status(fiber.StatusCreated).JSON(response)
+}
+
+// ResolveKeyComment func for resolving a comment thread of a key.
+func ResolveKeyComment(c *fiber.Ctx) error {
+	return updateKeyCommentState(c, true)
+}
+
+// ReopenKeyComment func for reopening a resolved comment thread of a key.
+func ReopenKeyComment(c *fiber.Ctx) error {
+	return updateKeyCommentState(c, false)
+}
+
+// DeleteKeyComment func for deleting a comment of a key.
+func DeleteKeyComment(c *fiber.Ctx) error {
+	key, comment, err := getKeyComment(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	if err := services.DeleteKeyComment(comment); err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	response := responses.KeyComment{}
+	response.SetKeyComment(comment)
+
+	services.DispatchWebhookEvent(key.AppName, enums.COMMENT_DELETED, response)
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// updateKeyCommentState resolves or reopens a comment thread of a key.
+func updateKeyCommentState(c *fiber.Ctx, resolve bool) error {
+	key, comment, err := getKeyComment(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	if comment.ParentID.Valid {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.CommentExists, "Only comment threads can be resolved or reopened.")
+	}
+
+	event := enums.COMMENT_REOPENED
+	if resolve {
+		author := strings.TrimSpace(c.Get(headerUserID))
+		if author == "" {
+			return errorutil.Response(c, fiber.StatusBadRequest, errorutil.MissingRequiredParam, headerUserID+" header is required.")
+		}
+
+		err = services.ResolveKeyComment(comment, author)
+		event = enums.COMMENT_RESOLVED
+	} else {
+		err = services.ReopenKeyComment(comment)
+	}
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	response := responses.KeyComment{}
+	response.SetKeyComment(comment)
+
+	services.DispatchWebhookEvent(key.AppName, event, response)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// getKeyComment gets the key and comment of the URL. When either does not exist, a response error is returned.
+func getKeyComment(c *fiber.Ctx) (*models.Key, *models.KeyComment, error) {
+	keyID, err := util.StringToUint(c.Params("id"))
+	if err != nil {
+		return nil, nil, newResponseError(fiber.StatusBadRequest, errorutil.InvalidParam, err.Error())
+	}
+
+	commentID, err := util.StringToUint(c.Params("commentId"))
+	if err != nil {
+		return nil, nil, newResponseError(fiber.StatusBadRequest, errorutil.InvalidParam, err.Error())
+	}
+
+	// Check if the key exists.
+	key, err := services.GetKeyByID(keyID)
+	if err != nil {
+		return nil, nil, err
+	} else if key.ID == 0 {
+		return nil, nil, newResponseError(fiber.StatusNotFound, errors.KeyExists, "Key does not exist.")
+	}
+
+	comment, err := services.GetKeyCommentByID(key.ID, commentID)
+	if err != nil {
+		return nil, nil, err
+	} else if comment.ID == 0 {
+		return nil, nil, newResponseError(fiber.StatusNotFound, errors.CommentExists, "Comment does not exist.")
+	}
+
+	return key, comment, nil
+}
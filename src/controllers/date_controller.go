@@ -0,0 +1,126 @@
+package controllers
+
+import (
+	"api-i18n/main/src/dto/responses"
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/errors"
+	"api-i18n/main/src/services"
+	"api-i18n/main/src/utils"
+	goerrors "errors"
+	"strconv"
+	"time"
+
+	errorutil "github.com/ArnoldPMolenaar/api-utils/errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// FormatDate func for formatting a timestamp for a locale and time zone by style or skeleton.
+func FormatDate(c *fiber.Ctx) error {
+	localeID, err := localeParam(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	timestamp := time.Now().UTC()
+	if timestampParam := c.Query("timestamp"); timestampParam != "" {
+		if parsed, err := time.Parse(time.RFC3339Nano, timestampParam); err == nil {
+			timestamp = parsed
+		} else if seconds, err := strconv.ParseInt(timestampParam, 10, 64); err == nil {
+			timestamp = time.Unix(seconds, 0).UTC()
+		} else {
+			return errorutil.Response(c, fiber.StatusBadRequest, errors.InvalidTimestamp, "timestamp must be an RFC 3339 date or unix seconds.")
+		}
+	}
+
+	location := time.UTC
+	if timeZoneParam := c.Query("timeZone"); timeZoneParam != "" {
+		if location, err = time.LoadLocation(timeZoneParam); err != nil {
+			return errorutil.Response(c, fiber.StatusBadRequest, errors.InvalidTimeZone, "Time zone not found.")
+		}
+	}
+
+	options := services.DateFormatOptions{}
+	for _, param := range []struct {
+		name  string
+		value **enums.DateStyle
+	}{{"dateStyle", &options.DateStyle}, {"timeStyle", &options.TimeStyle}} {
+		styleParam := c.Query(param.name)
+		if styleParam == "" {
+			continue
+		}
+		var style enums.DateStyle
+		style.Convert(styleParam)
+		if style == "" {
+			return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, param.name+" must be short, medium, long or full.")
+		}
+		*param.value = &style
+	}
+
+	if skeletonParam := c.Query("skeleton"); skeletonParam != "" {
+		if options.DateStyle != nil || options.TimeStyle != nil {
+			return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "skeleton can not be combined with dateStyle or timeStyle.")
+		}
+		options.Skeleton = &skeletonParam
+	} else if options.DateStyle == nil && options.TimeStyle == nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "dateStyle, timeStyle or skeleton query parameter is required.")
+	}
+
+	formatted, pattern, calendarFormat, err := services.FormatDate(localeID, timestamp, location, options)
+	if err != nil {
+		return dateFormatError(c, err)
+	}
+
+	response := responses.FormattedDate{}
+	response.SetFormattedDate(calendarFormat.LocaleID, location.String(), pattern, timestamp.Format(time.RFC3339Nano), formatted)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// GetDatePatterns func for getting the raw Gregorian calendar patterns and names of a locale.
+func GetDatePatterns(c *fiber.Ctx) error {
+	localeID, err := localeParam(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	calendarFormat, err := services.GetCalendarFormat(localeID)
+	if err != nil {
+		return dateFormatError(c, err)
+	}
+
+	response := responses.CalendarPatterns{}
+	response.SetCalendarPatterns(calendarFormat)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// localeParam parses and resolves the localeId query parameter.
+// An invalid parameter returns a response error.
+func localeParam(c *fiber.Ctx) (string, error) {
+	localeIDParam := c.Query("localeId")
+	if localeIDParam == "" {
+		return "", newResponseError(fiber.StatusBadRequest, errorutil.InvalidParam, "localeId query parameter is required.")
+	}
+
+	// Resolve the locale id for backwards compatibility.
+	resolvedLocaleId, err := utils.ResolveLocaleId(localeIDParam)
+	if err != nil {
+		return "", err
+	} else if resolvedLocaleId == nil {
+		return "", newResponseError(fiber.StatusBadRequest, errors.LocaleNotFound, "Locale not found.")
+	}
+
+	return *resolvedLocaleId, nil
+}
+
+// dateFormatError sends the error response of a date format service error.
+func dateFormatError(c *fiber.Ctx, err error) error {
+	switch {
+	case goerrors.Is(err, services.ErrCalendarFormatNotFound):
+		return errorutil.Response(c, fiber.StatusNotFound, errors.CalendarFormatNotFound, "Calendar format of locale not found.")
+	case goerrors.Is(err, services.ErrDatePatternNotFound):
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.DatePatternNotFound, "No date pattern matches the style or skeleton.")
+	default:
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+}
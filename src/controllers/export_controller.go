@@ -0,0 +1,57 @@
+package controllers
+
+import (
+	"api-i18n/main/src/errors"
+	"api-i18n/main/src/services"
+
+	errorutil "github.com/ArnoldPMolenaar/api-utils/errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ExportKeysXLIFF func for exporting the keys of an app as XLIFF 1.2.
+// The target locale is set with localeId, the source locale defaults to the app source locale.
+func ExportKeysXLIFF(c *fiber.Ctx) error {
+	appName := c.Query("app")
+	targetLocaleID := c.Query("localeId")
+	if targetLocaleID == "" {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "localeId query parameter is required.")
+	}
+
+	// Check if the app exists.
+	appAvailable, err := services.IsAppAvailable(appName)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if !appAvailable {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.AppNotFound, "App not found.")
+	}
+
+	// Get the source locale.
+	sourceLocaleID := c.Query("sourceLocaleId")
+	if sourceLocaleID == "" {
+		appSourceLocaleID, err := services.GetAppSourceLocaleID(appName)
+		if err != nil {
+			return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+		} else if appSourceLocaleID == nil {
+			return errorutil.Response(c, fiber.StatusBadRequest, errors.SourceLocaleNotFound, "Source locale not set in app.")
+		}
+		sourceLocaleID = *appSourceLocaleID
+	}
+
+	// Check if locales are set in the app.
+	hasLocales, err := HasAppLocales(appName, sourceLocaleID, targetLocaleID)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if !hasLocales {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.LocaleNotFound, "Locale not found in app.")
+	}
+
+	document, err := services.ExportXLIFF(appName, sourceLocaleID, targetLocaleID)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	c.Set(fiber.HeaderContentType, "application/x-xliff+xml; charset=utf-8")
+	c.Attachment(appName + "." + targetLocaleID + ".xlf")
+
+	return c.Status(fiber.StatusOK).Send(document)
+}
@@ -0,0 +1,277 @@
+package controllers
+
+import (
+	"api-i18n/main/src/dto/requests"
+	"api-i18n/main/src/dto/responses"
+	"api-i18n/main/src/errors"
+	"api-i18n/main/src/services"
+	goerrors "errors"
+	"io"
+
+	errorutil "github.com/ArnoldPMolenaar/api-utils/errors"
+	util "github.com/ArnoldPMolenaar/api-utils/utils"
+	"github.com/gofiber/fiber/v2"
+	"github.com/samber/lo"
+)
+
+// GetGlossaryTerms func for getting all glossary terms paginated.
+func GetGlossaryTerms(c *fiber.Ctx) error {
+	paginationModel, err := services.GetGlossaryTerms(c)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	return c.Status(fiber.StatusOK).JSON(paginationModel)
+}
+
+// GetGlossaryTermByID func for getting a glossary term by ID.
+func GetGlossaryTermByID(c *fiber.Ctx) error {
+	termID, err := util.StringToUint(c.Params("id"))
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, err.Error())
+	}
+
+	term, err := services.GetGlossaryTermByID(termID)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if term.ID == 0 {
+		return errorutil.Response(c, fiber.StatusNotFound, errors.GlossaryTermExists, "Glossary term does not exist.")
+	}
+
+	response := responses.GlossaryTerm{}
+	response.SetGlossaryTerm(term)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// CreateGlossaryTerm func for creating a glossary term.
+func CreateGlossaryTerm(c *fiber.Ctx) error {
+	// Create a new glossary term struct for the request.
+	termRequest := &requests.CreateGlossaryTerm{}
+
+	// Check, if received JSON data is parsed.
+	if err := c.BodyParser(termRequest); err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.BodyParse, err.Error())
+	}
+
+	// Validate glossary term fields.
+	validate := util.NewValidator()
+	if err := validate.Struct(termRequest); err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.Validator, util.ValidatorErrors(err))
+	}
+
+	// Check if the app exists.
+	appAvailable, err := services.IsAppAvailable(termRequest.AppName)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if !appAvailable {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.AppNotFound, "App not found.")
+	}
+
+	// Check if the glossary term exists.
+	if available, err := services.IsGlossaryTermAvailable(termRequest.AppName, termRequest.LocaleID, termRequest.Term, nil); err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if !available {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.GlossaryTermAvailable, "Glossary term already exist.")
+	}
+
+	// Check if the source and translation locales are set in the app.
+	if valid, err := hasValidGlossaryLocales(termRequest.AppName, termRequest.LocaleID, termRequest.Translations); err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if !valid {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.InvalidTranslations, "One or more translations are invalid.")
+	}
+
+	// Create glossary term.
+	term, err := services.CreateGlossaryTerm(*termRequest)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	// Return the glossary term.
+	response := responses.GlossaryTerm{}
+	response.SetGlossaryTerm(term)
+
+	return c.Status(fiber.StatusCreated).JSON(response)
+}
+
+// UpdateGlossaryTerm func for updating a glossary term.
+func UpdateGlossaryTerm(c *fiber.Ctx) error {
+	// Get the termID parameter from the URL.
+	termID, err := util.StringToUint(c.Params("id"))
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, err.Error())
+	}
+
+	// Create a new glossary term struct for the request.
+	termRequest := &requests.UpdateGlossaryTerm{}
+
+	// Check, if received JSON data is parsed.
+	if err := c.BodyParser(termRequest); err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.BodyParse, err.Error())
+	}
+
+	// Validate glossary term fields.
+	validate := util.NewValidator()
+	if err := validate.Struct(termRequest); err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.Validator, util.ValidatorErrors(err))
+	}
+
+	// Get old glossary term.
+	oldTerm, err := services.GetGlossaryTermByID(termID)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if oldTerm.ID == 0 {
+		return errorutil.Response(c, fiber.StatusNotFound, errors.GlossaryTermExists, "Glossary term does not exist.")
+	}
+
+	// Check if the glossary term has been modified since it was last fetched.
+	if termRequest.UpdatedAt.Unix() < oldTerm.UpdatedAt.Unix() {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.OutOfSync, "Data is out of sync.")
+	}
+
+	// Check if the glossary term exists.
+	if termRequest.Term != oldTerm.Term || termRequest.LocaleID != oldTerm.LocaleID {
+		if available, err := services.IsGlossaryTermAvailable(oldTerm.AppName, termRequest.LocaleID, termRequest.Term, &oldTerm.ID); err != nil {
+			return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+		} else if !available {
+			return errorutil.Response(c, fiber.StatusBadRequest, errors.GlossaryTermAvailable, "Glossary term already exist.")
+		}
+	}
+
+	// Check if the source and translation locales are set in the app.
+	if valid, err := hasValidGlossaryLocales(oldTerm.AppName, termRequest.LocaleID, termRequest.Translations); err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if !valid {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.InvalidTranslations, "One or more translations are invalid.")
+	}
+
+	// Update glossary term.
+	term, err := services.UpdateGlossaryTerm(*oldTerm, *termRequest)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	// Return the glossary term.
+	response := responses.GlossaryTerm{}
+	response.SetGlossaryTerm(term)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// DeleteGlossaryTerm func for deleting a glossary term.
+func DeleteGlossaryTerm(c *fiber.Ctx) error {
+	// Get the ID from the URL.
+	id, err := util.StringToUint(c.Params("id"))
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, err.Error())
+	}
+
+	// Find the glossary term.
+	term, err := services.GetGlossaryTermByID(id)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if term.ID == 0 {
+		return errorutil.Response(c, fiber.StatusNotFound, errors.GlossaryTermExists, "Glossary term does not exist.")
+	}
+
+	// Delete the glossary term.
+	if err := services.DeleteGlossaryTerm(term.ID); err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// ImportGlossaryTBX func for importing a TBX document into the glossary of an app.
+// The document is read from the "file" form field or, when absent, from the raw request body.
+func ImportGlossaryTBX(c *fiber.Ctx) error {
+	appName := c.Query("app")
+
+	// Check if the app exists.
+	appAvailable, err := services.IsAppAvailable(appName)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if !appAvailable {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.AppNotFound, "App not found.")
+	}
+
+	data := c.Body()
+	if fileHeader, err := c.FormFile("file"); err == nil {
+		file, err := fileHeader.Open()
+		if err != nil {
+			return errorutil.Response(c, fiber.StatusBadRequest, errorutil.BodyParse, err.Error())
+		}
+		defer file.Close()
+
+		if data, err = io.ReadAll(file); err != nil {
+			return errorutil.Response(c, fiber.StatusBadRequest, errorutil.BodyParse, err.Error())
+		}
+	}
+
+	result, err := services.ImportGlossaryTBX(appName, data)
+	if goerrors.Is(err, services.ErrInvalidTBX) {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.InvalidTBX, err.Error())
+	} else if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+// ExportGlossaryTBX func for exporting the glossary of an app as a TBX document.
+func ExportGlossaryTBX(c *fiber.Ctx) error {
+	appName := c.Query("app")
+
+	// Check if the app exists.
+	appAvailable, err := services.IsAppAvailable(appName)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if !appAvailable {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.AppNotFound, "App not found.")
+	}
+
+	document, err := services.ExportGlossaryTBX(appName)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	c.Set(fiber.HeaderContentType, "application/x-tbx+xml; charset=utf-8")
+	c.Attachment(appName + ".tbx")
+
+	return c.Status(fiber.StatusOK).Send(document)
+}
+
+// GetGlossaryCompliance func for getting the glossary compliance report of an app.
+func GetGlossaryCompliance(c *fiber.Ctx) error {
+	appName := c.Query("app")
+
+	// Check if the app exists.
+	appAvailable, err := services.IsAppAvailable(appName)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if !appAvailable {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.AppNotFound, "App not found.")
+	}
+
+	checkedKeys, violations, err := services.GetGlossaryCompliance(appName)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	response := responses.GlossaryCompliance{}
+	response.SetGlossaryCompliance(appName, checkedKeys, violations)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// hasValidGlossaryLocales checks if the source and translation locales are set in the app
+// and that every translation targets its own locale other than the source locale.
+func hasValidGlossaryLocales(appName, localeID string, translations []requests.GlossaryTermTranslation) (bool, error) {
+	localeIds := lo.Map(translations, func(t requests.GlossaryTermTranslation, _ int) string { return t.LocaleID })
+	if lo.Contains(localeIds, localeID) || len(lo.Uniq(localeIds)) != len(localeIds) {
+		return false, nil
+	}
+
+	return HasAppLocales(appName, append(localeIds, localeID)...)
+}
@@ -3,8 +3,11 @@ package controllers
 import (
 	"api-i18n/main/src/dto/requests"
 	"api-i18n/main/src/dto/responses"
+	"api-i18n/main/src/enums"
 	"api-i18n/main/src/errors"
+	"api-i18n/main/src/models"
 	"api-i18n/main/src/services"
+	"strings"
 
 	errorutil "github.com/ArnoldPMolenaar/api-utils/errors"
 	util "github.com/ArnoldPMolenaar/api-utils/utils"
@@ -22,6 +25,57 @@ func GetKeys(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(paginationModel)
 }
 
+// SearchKeys func for searching the names, descriptions and translation values of the keys of an app.
+func SearchKeys(c *fiber.Ctx) error {
+	appName := c.Query("app")
+	query := strings.TrimSpace(c.Query("q"))
+	if query == "" {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "q query parameter is required.")
+	}
+
+	// Check if the app exists.
+	appAvailable, err := services.IsAppAvailable(appName)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if !appAvailable {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.AppNotFound, "App not found.")
+	}
+
+	filter := services.KeySearchFilter{}
+	if localeIDParam := c.Query("localeId"); localeIDParam != "" {
+		filter.LocaleID = &localeIDParam
+	}
+	if categoryIDParam := c.Query("categoryId"); categoryIDParam != "" {
+		categoryID, err := util.StringToUint(categoryIDParam)
+		if err != nil {
+			return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, err.Error())
+		}
+		filter.CategoryID = &categoryID
+	}
+	if valueTypeParam := c.Query("valueType"); valueTypeParam != "" {
+		if valueTypeParam != enums.TEXT.String() && valueTypeParam != enums.HTML.String() && valueTypeParam != enums.JSON.String() {
+			return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "Invalid valueType query parameter.")
+		}
+		filter.ValueType = &valueTypeParam
+	}
+
+	page := c.QueryInt("page", 1)
+	if page < 1 {
+		page = 1
+	}
+	limit := c.QueryInt("limit", 10)
+	if limit < 1 {
+		limit = 10
+	}
+
+	paginationModel, err := services.SearchKeys(appName, query, filter, page, limit)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	return c.Status(fiber.StatusOK).JSON(paginationModel)
+}
+
 // GetKeyByID func for getting a key by ID.
 func GetKeyByID(c *fiber.Ctx) error {
 	keyIDParam := c.Params("id")
@@ -89,6 +143,15 @@ func CreateKey(c *fiber.Ctx) error {
 		return errorutil.Response(c, fiber.StatusBadRequest, errors.InvalidTranslations, "One or more translations are invalid.")
 	}
 
+	// Check if the translations fit the length limits of the key.
+	if valid, err := hasValidLengthLimitLocales(keyRequest.AppName, keyRequest.LengthLimits); err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if !valid {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.InvalidLengthLimits, "One or more length limits are invalid.")
+	} else if violations := services.CheckCreateKeyLengthLimits(*keyRequest); len(violations) > 0 {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.KeyLengthExceeded, violations)
+	}
+
 	// Create key.
 	key, err := services.CreateKey(*keyRequest)
 	if err != nil {
@@ -184,6 +247,15 @@ func UpdateKey(c *fiber.Ctx) error {
 		return errorutil.Response(c, fiber.StatusBadRequest, errors.InvalidTranslations, "One or more translations are invalid.")
 	}
 
+	// Check if the translations fit the length limits of the key.
+	if valid, err := hasValidLengthLimitLocales(oldKey.AppName, keyRequest.LengthLimits); err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if !valid {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.InvalidLengthLimits, "One or more length limits are invalid.")
+	} else if violations := services.CheckUpdateKeyLengthLimits(*oldKey, *keyRequest); len(violations) > 0 {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.KeyLengthExceeded, violations)
+	}
+
 	// Update key.
 	updatedKey, err := services.UpdateKey(*oldKey, *keyRequest)
 	if err != nil {
@@ -197,9 +269,16 @@ func UpdateKey(c *fiber.Ctx) error {
 		return errorutil.Response(c, fiber.StatusNotFound, errors.KeyExists, "Key does not exist.")
 	}
 
+	// Check the saved translations against the glossary of the app.
+	violations, err := services.CheckGlossaryCompliance(key.AppName, []models.Key{*key})
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
 	// Return the key.
 	response := responses.Key{}
 	response.SetKey(key)
+	response.Warnings = violations
 
 	return c.Status(fiber.StatusOK).JSON(response)
 }
@@ -250,3 +329,44 @@ func RestoreKey(c *fiber.Ctx) error {
 
 	return c.SendStatus(fiber.StatusNoContent)
 }
+
+// GetKeyLengthViolations func for getting the translations of an app that exceed the length limits of their key.
+func GetKeyLengthViolations(c *fiber.Ctx) error {
+	appName := c.Query("app")
+
+	// Check if the app exists.
+	appAvailable, err := services.IsAppAvailable(appName)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if !appAvailable {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.AppNotFound, "App not found.")
+	}
+
+	var localeID *string
+	if c.Query("localeId") != "" {
+		value := c.Query("localeId")
+		localeID = &value
+	}
+
+	checkedKeys, violations, err := services.GetKeyLengthViolations(appName, localeID)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	response := responses.KeyLengthReport{}
+	response.SetKeyLengthReport(appName, checkedKeys, violations)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// hasValidLengthLimitLocales checks that every length limit override targets its own locale of the app.
+func hasValidLengthLimitLocales(appName string, limits []requests.KeyLengthLimit) (bool, error) {
+	localeIds := lo.Map(limits, func(l requests.KeyLengthLimit, _ int) string { return l.LocaleID })
+	if len(localeIds) == 0 {
+		return true, nil
+	} else if len(lo.Uniq(localeIds)) != len(localeIds) {
+		return false, nil
+	}
+
+	return HasAppLocales(appName, localeIds...)
+}
@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"api-i18n/main/src/dto/responses"
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/errors"
+	"api-i18n/main/src/services"
+	goerrors "errors"
+
+	errorutil "github.com/ArnoldPMolenaar/api-utils/errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// FormatList func for joining a list of items for a locale, e.g. "A, B and C".
+func FormatList(c *fiber.Ctx) error {
+	items := make([]string, 0)
+	for _, item := range c.Context().QueryArgs().PeekMulti("items") {
+		items = append(items, string(item))
+	}
+	if len(items) == 0 {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "items query parameter is required.")
+	}
+
+	listType := enums.LIST_AND
+	if typeParam := c.Query("type"); typeParam != "" {
+		listType = ""
+		listType.Convert(typeParam)
+		if listType == "" {
+			return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "type must be and, or or unit.")
+		}
+	}
+
+	width := enums.WIDTH_LONG
+	if styleParam := c.Query("style"); styleParam != "" {
+		width = ""
+		width.Convert(styleParam)
+		if width == "" {
+			return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "style must be long, short or narrow.")
+		}
+	}
+
+	localeID, err := localeParam(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	formatted, listPattern, err := services.FormatList(localeID, items, listType, width)
+	if err != nil {
+		if goerrors.Is(err, services.ErrListPatternNotFound) {
+			return errorutil.Response(c, fiber.StatusNotFound, errors.ListPatternNotFound, "List pattern of locale not found.")
+		}
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	response := responses.FormattedList{}
+	response.SetFormattedList(listPattern.LocaleID, listPattern.Type.String(), listPattern.Width.String(), items, formatted)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
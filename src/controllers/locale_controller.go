@@ -2,15 +2,18 @@ package controllers
 
 import (
 	"api-i18n/main/src/dto/responses"
+	"api-i18n/main/src/enums"
 	"api-i18n/main/src/errors"
 	"api-i18n/main/src/services"
 	"api-i18n/main/src/utils"
+	"strconv"
 
 	errorutil "github.com/ArnoldPMolenaar/api-utils/errors"
 	"github.com/gofiber/fiber/v2"
 )
 
-// GetLocaleLookup func for getting locale lookup by locale ID, optional name filter.
+// GetLocaleLookup func for getting locale lookup by locale ID, optional language display, style and name filter.
+// With details, every locale also has its autonym, text direction, coverage level, numbering system and parent.
 func GetLocaleLookup(c *fiber.Ctx) error {
 	localeIDParam := c.Query("localeId")
 	if localeIDParam == "" {
@@ -23,13 +26,29 @@ func GetLocaleLookup(c *fiber.Ctx) error {
 		name = &nameParam
 	}
 
+	details := false
+	if c.Query("details") != "" {
+		value, err := strconv.ParseBool(c.Query("details"))
+		if err != nil {
+			return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "details must be true or false.")
+		}
+		details = value
+	}
+
+	display, width, err := localeDisplayParams(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
 	// Resolve the locale id for backwards compatibility.
-	resolvedLocaleId := utils.ResolveLocaleId(localeIDParam)
-	if resolvedLocaleId == nil {
+	resolvedLocaleId, err := utils.ResolveLocaleId(localeIDParam)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if resolvedLocaleId == nil {
 		return errorutil.Response(c, fiber.StatusBadRequest, errors.LocaleNotFound, "Locale not found.")
 	}
 
-	locales, err := services.GetLocaleLookup(*resolvedLocaleId, name)
+	locales, err := services.GetLocaleLookup(*resolvedLocaleId, display, width, name)
 	if err != nil {
 		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
 	}
@@ -37,5 +56,149 @@ func GetLocaleLookup(c *fiber.Ctx) error {
 	response := responses.LocaleLookupList{}
 	response.SetLocaleLookupList(locales)
 
+	if details {
+		autonyms, err := services.GetLocaleAutonyms(display, width)
+		if err != nil {
+			return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+		}
+
+		metadata, err := services.GetLocaleMetadataList()
+		if err != nil {
+			return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+		}
+
+		response.SetLocaleLookupListDetails(autonyms, metadata)
+	}
+
 	return c.Status(fiber.StatusOK).JSON(response)
 }
+
+// GetLocale func for getting a locale with its autonym and metadata. With the optional localeId
+// query parameter, the name of the locale in that viewer locale is included.
+func GetLocale(c *fiber.Ctx) error {
+	resolvedID, err := utils.ResolveLocaleId(c.Params("id"))
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if resolvedID == nil {
+		return errorutil.Response(c, fiber.StatusNotFound, errors.LocaleNotFound, "Locale not found.")
+	}
+
+	display, width, err := localeDisplayParams(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	var viewerID *string
+	if localeIDParam := c.Query("localeId"); localeIDParam != "" {
+		if viewerID, err = utils.ResolveLocaleId(localeIDParam); err != nil {
+			return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+		} else if viewerID == nil {
+			return errorutil.Response(c, fiber.StatusBadRequest, errors.LocaleNotFound, "Viewer locale not found.")
+		}
+	}
+
+	metadata, err := services.GetLocaleMetadata(*resolvedID)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if metadata.LocaleID == "" {
+		return errorutil.Response(c, fiber.StatusNotFound, errors.LocaleNotFound, "Locale metadata not found.")
+	}
+
+	autonym, err := services.GetLocaleDisplayName(metadata.LocaleID, &metadata.Locale, display, width)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	var name *string
+	if viewerID != nil {
+		viewerName, err := services.GetLocaleDisplayName(*viewerID, &metadata.Locale, display, width)
+		if err != nil {
+			return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+		}
+		name = &viewerName
+	}
+
+	response := responses.LocaleInfo{}
+	response.SetLocaleInfo(metadata, autonym, name)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// GetPluralRules func for getting the cardinal and ordinal plural rules of a locale.
+func GetPluralRules(c *fiber.Ctx) error {
+	localeID, rules, err := services.GetPluralRules(c.Params("id"))
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if localeID == "" {
+		return errorutil.Response(c, fiber.StatusNotFound, errors.LocaleNotFound, "Plural rules of locale not found.")
+	}
+
+	response := responses.PluralRuleList{}
+	response.SetPluralRuleList(localeID, rules)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// EvaluatePluralRule func for getting the plural category of a number in a locale.
+// The number keeps its visible fraction digits, so "1" and "1.0" may have different categories.
+func EvaluatePluralRule(c *fiber.Ctx) error {
+	number := c.Query("number")
+	if number == "" {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "number query parameter is required.")
+	}
+
+	pluralType := enums.CARDINAL
+	if typeParam := c.Query("type"); typeParam != "" {
+		pluralType = ""
+		pluralType.Convert(typeParam)
+		if pluralType == "" {
+			return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "type must be cardinal or ordinal.")
+		}
+	}
+
+	operands, err := services.NewPluralOperands(number)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.InvalidNumber, "Number must be a decimal number like 1, 1.50 or 1.2c6 of at most 100 characters, with an exponent up to 21.")
+	}
+
+	localeID, rules, err := services.GetPluralRules(c.Params("id"))
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if localeID == "" {
+		return errorutil.Response(c, fiber.StatusNotFound, errors.LocaleNotFound, "Plural rules of locale not found.")
+	}
+
+	category, err := services.EvaluatePluralCategory(rules, pluralType, operands)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.InternalServerError, err.Error())
+	}
+
+	response := responses.PluralCategory{}
+	response.SetPluralCategory(localeID, number, pluralType.String(), category, operands.Values())
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// localeDisplayParams reads the languageDisplay and style query parameters of locale display names,
+// defaulting to the dialect display and the long style. An invalid parameter returns a response error.
+func localeDisplayParams(c *fiber.Ctx) (enums.LanguageDisplay, enums.FormatWidth, error) {
+	display := enums.DIALECT
+	if displayParam := c.Query("languageDisplay"); displayParam != "" {
+		display = ""
+		display.Convert(displayParam)
+		if display == "" {
+			return "", "", newResponseError(fiber.StatusBadRequest, errorutil.InvalidParam, "languageDisplay must be dialect or standard.")
+		}
+	}
+
+	width := enums.WIDTH_LONG
+	if styleParam := c.Query("style"); styleParam != "" {
+		width = ""
+		width.Convert(styleParam)
+		if width != enums.WIDTH_LONG && width != enums.WIDTH_SHORT {
+			return "", "", newResponseError(fiber.StatusBadRequest, errorutil.InvalidParam, "style must be long or short.")
+		}
+	}
+
+	return display, width, nil
+}
@@ -0,0 +1,144 @@
+package controllers
+
+import (
+	"api-i18n/main/src/dto/responses"
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/errors"
+	"api-i18n/main/src/services"
+	"api-i18n/main/src/utils"
+	goerrors "errors"
+	"strconv"
+	"strings"
+
+	errorutil "github.com/ArnoldPMolenaar/api-utils/errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// FormatNumber func for formatting a number, percentage or currency amount for a locale.
+func FormatNumber(c *fiber.Ctx) error {
+	number := c.Query("number")
+	if number == "" {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "number query parameter is required.")
+	}
+
+	localeID, options, err := numberFormatParams(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	formatted, numberFormat, err := services.FormatNumber(localeID, number, options)
+	if err != nil {
+		return numberFormatError(c, err)
+	}
+
+	response := responses.FormattedNumber{}
+	response.SetFormattedNumber(numberFormat.LocaleID, numberFormat.NumberingSystemID, options.Style.String(), options.CurrencyID, number, formatted)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// ParseNumber func for parsing a number, percentage or currency amount formatted for a locale.
+func ParseNumber(c *fiber.Ctx) error {
+	value := c.Query("value")
+	if value == "" {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "value query parameter is required.")
+	}
+
+	localeID, options, err := numberFormatParams(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	number, numberFormat, err := services.ParseNumber(localeID, value, options)
+	if err != nil {
+		return numberFormatError(c, err)
+	}
+
+	response := responses.FormattedNumber{}
+	response.SetFormattedNumber(numberFormat.LocaleID, numberFormat.NumberingSystemID, options.Style.String(), options.CurrencyID, number, value)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// numberFormatParams parses the locale and number format options of the query.
+// An invalid parameter returns a response error.
+func numberFormatParams(c *fiber.Ctx) (string, services.NumberFormatOptions, error) {
+	options := services.NumberFormatOptions{Style: enums.DECIMAL, CurrencyDisplay: enums.SYMBOL}
+
+	localeIDParam := c.Query("localeId")
+	if localeIDParam == "" {
+		return "", options, newResponseError(fiber.StatusBadRequest, errorutil.InvalidParam, "localeId query parameter is required.")
+	}
+
+	if styleParam := c.Query("style"); styleParam != "" {
+		options.Style = ""
+		options.Style.Convert(styleParam)
+		if options.Style == "" {
+			return "", options, newResponseError(fiber.StatusBadRequest, errorutil.InvalidParam, "style must be decimal, percent, currency or accounting.")
+		}
+	}
+
+	if displayParam := c.Query("currencyDisplay"); displayParam != "" {
+		options.CurrencyDisplay = ""
+		options.CurrencyDisplay.Convert(displayParam)
+		if options.CurrencyDisplay == "" {
+			return "", options, newResponseError(fiber.StatusBadRequest, errorutil.InvalidParam, "currencyDisplay must be symbol, narrowSymbol or code.")
+		}
+	}
+
+	if currencyParam := strings.ToUpper(c.Query("currency")); currencyParam != "" {
+		options.CurrencyID = &currencyParam
+	} else if options.Style.IsCurrency() {
+		return "", options, newResponseError(fiber.StatusBadRequest, errorutil.InvalidParam, "currency query parameter is required for currency styles.")
+	}
+
+	if numberingSystemParam := c.Query("numberingSystem"); numberingSystemParam != "" {
+		options.NumberingSystemID = &numberingSystemParam
+	}
+
+	if cashParam := c.Query("cash"); cashParam != "" {
+		cash, err := strconv.ParseBool(cashParam)
+		if err != nil {
+			return "", options, newResponseError(fiber.StatusBadRequest, errorutil.InvalidParam, "cash must be true or false.")
+		}
+		options.Cash = cash
+	}
+
+	for _, param := range []struct {
+		name  string
+		value **int
+	}{{"minimumFractionDigits", &options.MinimumFractionDigits}, {"maximumFractionDigits", &options.MaximumFractionDigits}} {
+		if c.Query(param.name) == "" {
+			continue
+		}
+		digits := c.QueryInt(param.name, -1)
+		if digits < 0 || digits > 20 {
+			return "", options, newResponseError(fiber.StatusBadRequest, errorutil.InvalidParam, param.name+" must be between 0 and 20.")
+		}
+		*param.value = &digits
+	}
+
+	// Resolve the locale id for backwards compatibility.
+	resolvedLocaleId, err := utils.ResolveLocaleId(localeIDParam)
+	if err != nil {
+		return "", options, err
+	} else if resolvedLocaleId == nil {
+		return "", options, newResponseError(fiber.StatusBadRequest, errors.LocaleNotFound, "Locale not found.")
+	}
+
+	return *resolvedLocaleId, options, nil
+}
+
+// numberFormatError sends the error response of a number format service error.
+func numberFormatError(c *fiber.Ctx, err error) error {
+	switch {
+	case goerrors.Is(err, services.ErrInvalidNumber):
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.InvalidNumber, "Number is invalid.")
+	case goerrors.Is(err, services.ErrNumberFormatNotFound):
+		return errorutil.Response(c, fiber.StatusNotFound, errors.NumberFormatNotFound, "Number format of locale not found.")
+	case goerrors.Is(err, services.ErrCurrencyNotFound):
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.CurrencyNotFound, "Currency not found.")
+	default:
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+}
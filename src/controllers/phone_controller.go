@@ -1,31 +1,64 @@
 package controllers
 
 import (
+	"api-i18n/main/src/dto/requests"
 	"api-i18n/main/src/dto/responses"
 	"api-i18n/main/src/errors"
 	"api-i18n/main/src/services"
 	"api-i18n/main/src/utils"
+	"bufio"
+	"bytes"
+	goerrors "errors"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
 
 	errorutil "github.com/ArnoldPMolenaar/api-utils/errors"
+	util "github.com/ArnoldPMolenaar/api-utils/utils"
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
 )
 
-// GetPhoneLookup handles the phone code lookup request.
+// GetPhoneLookup handles the phone code lookup request, optionally with the phone metadata of every territory.
 func GetPhoneLookup(c *fiber.Ctx) error {
-	localeIDParam := c.Query("localeId")
-	if localeIDParam == "" {
-		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "localeId query parameter is required.")
+	localeID, withMetadata, err := phoneLookupParams(c)
+	if err != nil {
+		return errorResponse(c, err)
 	}
 
-	// Resolve the locale id for backwards compatibility.
-	resolvedLocaleId := utils.ResolveLocaleId(localeIDParam)
-	if resolvedLocaleId == nil {
-		return errorutil.Response(c, fiber.StatusBadRequest, errors.LocaleNotFound, "Locale not found.")
+	phoneCodes, err := services.GetTerritoryPhoneCodes(localeID, withMetadata)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.InternalServerError, err.Error())
+	}
+
+	response := responses.PhoneCodeLookupList{}
+	response.SetPhoneCodeLookupList(phoneCodes)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// GetPhoneLookupByCode handles the lookup request of all territories sharing a dial code, e.g. +1, +7 or +44.
+func GetPhoneLookupByCode(c *fiber.Ctx) error {
+	codeParam, err := url.PathUnescape(c.Params("code"))
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, err.Error())
+	}
+	code, err := strconv.Atoi(strings.TrimPrefix(codeParam, "+"))
+	if err != nil || code <= 0 {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "code must be a country calling code, e.g. +44.")
 	}
 
-	phoneCodes, err := services.GetTerritoryPhoneCodes(*resolvedLocaleId)
+	localeID, withMetadata, err := phoneLookupParams(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	phoneCodes, err := services.GetTerritoryPhoneCodesByCode(localeID, code, withMetadata)
 	if err != nil {
 		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.InternalServerError, err.Error())
+	} else if len(*phoneCodes) == 0 {
+		return errorutil.Response(c, fiber.StatusNotFound, errors.PhoneCodeNotFound, "Phone code not found.")
 	}
 
 	response := responses.PhoneCodeLookupList{}
@@ -34,46 +67,212 @@ func GetPhoneLookup(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(response)
 }
 
+// GetPhoneMetadata handles the phone metadata request of a region, with example numbers and input templates.
+func GetPhoneMetadata(c *fiber.Ctx) error {
+	phoneMetadata, err := services.GetPhoneMetadata(c.Params("region"))
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.InternalServerError, err.Error())
+	} else if phoneMetadata == nil {
+		return errorutil.Response(c, fiber.StatusNotFound, errors.PhoneRegionNotFound, "Phone metadata of region not found.")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(phoneMetadata)
+}
+
 // GetPhoneNumberValidation handles the phone number validation request.
+// Without territoryId the number must be international, and every region it is valid for is returned.
 func GetPhoneNumberValidation(c *fiber.Ctx) error {
 	territoryIDParam := c.Query("territoryId")
 	phoneNumberParam := c.Query("phoneNumber")
 
-	if territoryIDParam == "" {
-		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "territoryId query parameter is required.")
-	}
 	if phoneNumberParam == "" {
 		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "phoneNumber query parameter is required.")
 	}
 
-	isValid, err := services.ValidatePhoneNumber(phoneNumberParam, &territoryIDParam)
-	if err != nil {
+	phoneNumberValid, err := services.ValidatePhoneNumber(phoneNumberParam, &territoryIDParam)
+	if goerrors.Is(err, services.ErrInvalidPhoneNumber) {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, invalidPhoneNumberMessage(territoryIDParam))
+	} else if err != nil {
 		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.InternalServerError, err.Error())
 	}
 
-	response := &responses.PhoneNumberValid{}
-	response.IsValid = isValid
-
-	return c.Status(fiber.StatusOK).JSON(response)
+	return c.Status(fiber.StatusOK).JSON(phoneNumberValid)
 }
 
 // GetPhoneNumberFormat handles the phone number format request.
-// To format a phone number according to the specified locale.
+// To format a phone number according to the specified locale, with carrier, location and time zones.
+// Without territoryId the number must be international.
 func GetPhoneNumberFormat(c *fiber.Ctx) error {
 	territoryIDParam := c.Query("territoryId")
 	phoneNumberParam := c.Query("phoneNumber")
 
-	if territoryIDParam == "" {
-		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "territoryId query parameter is required.")
-	}
 	if phoneNumberParam == "" {
 		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "phoneNumber query parameter is required.")
 	}
 
-	phoneNumberFormat, err := services.FormatPhoneNumber(phoneNumberParam, &territoryIDParam)
-	if err != nil {
+	// The carrier and location are in English without a locale.
+	localeID := "en"
+	if localeIDParam := c.Query("localeId"); localeIDParam != "" {
+		resolvedLocaleId, err := utils.ResolveLocaleId(localeIDParam)
+		if err != nil {
+			return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+		} else if resolvedLocaleId == nil {
+			return errorutil.Response(c, fiber.StatusBadRequest, errors.LocaleNotFound, "Locale not found.")
+		}
+		localeID = *resolvedLocaleId
+	}
+
+	phoneNumberFormat, err := services.FormatPhoneNumber(phoneNumberParam, &territoryIDParam, localeID)
+	if goerrors.Is(err, services.ErrInvalidPhoneNumber) {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, invalidPhoneNumberMessage(territoryIDParam))
+	} else if err != nil {
 		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.InternalServerError, err.Error())
 	}
 
 	return c.Status(fiber.StatusOK).JSON(phoneNumberFormat)
 }
+
+// GetPhoneNumberAsYouType handles the as-you-type phone number format request.
+// To format a partially typed phone number while the user types, with whether it is already possible or valid.
+// Without territoryId the number is international.
+func GetPhoneNumberAsYouType(c *fiber.Ctx) error {
+	territoryIDParam := c.Query("territoryId")
+	phoneNumberParam := c.Query("phoneNumber")
+
+	phoneNumberAsYouType, err := services.FormatPhoneNumberAsYouType(phoneNumberParam, &territoryIDParam)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.InternalServerError, err.Error())
+	}
+
+	return c.Status(fiber.StatusOK).JSON(phoneNumberAsYouType)
+}
+
+// FormatPhoneNumbers handles the batch phone number format request.
+// A JSON body returns the format and E.164 dedup key of every number; a CSV upload, as multipart file
+// or text/csv body of at most the body limit, is streamed back with the normalized columns appended to every row.
+func FormatPhoneNumbers(c *fiber.Ctx) error {
+	contentType := c.Get(fiber.HeaderContentType)
+	if strings.HasPrefix(contentType, fiber.MIMEMultipartForm) || strings.HasPrefix(contentType, "text/csv") {
+		return formatPhoneNumbersCSV(c)
+	}
+
+	request := &requests.FormatPhoneNumbers{}
+	if err := c.BodyParser(request); err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.BodyParse, err.Error())
+	}
+
+	// Validate batch fields.
+	validate := util.NewValidator()
+	if err := validate.Struct(request); err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.Validator, util.ValidatorErrors(err))
+	}
+
+	localeID := "en"
+	if request.LocaleID != nil && *request.LocaleID != "" {
+		resolvedLocaleId, err := utils.ResolveLocaleId(*request.LocaleID)
+		if err != nil {
+			return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+		} else if resolvedLocaleId == nil {
+			return errorutil.Response(c, fiber.StatusBadRequest, errors.LocaleNotFound, "Locale not found.")
+		}
+		localeID = *resolvedLocaleId
+	}
+
+	response := responses.PhoneNumberBatch{Numbers: make([]responses.PhoneNumberBatchItem, 0, len(request.Numbers))}
+	seen := make(map[string]bool, len(request.Numbers))
+	for _, number := range request.Numbers {
+		response.AddPhoneNumberBatchItem(services.FormatPhoneNumberBatchItem(number.PhoneNumber, number.TerritoryID, request.TerritoryID, localeID, seen))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// formatPhoneNumbersCSV streams the normalized CSV document of an uploaded CSV document.
+// The default region and locale are the territoryId and localeId query parameters.
+func formatPhoneNumbersCSV(c *fiber.Ctx) error {
+	var defaultRegion *string
+	if territoryIDParam := c.Query("territoryId"); territoryIDParam != "" {
+		defaultRegion = &territoryIDParam
+	}
+
+	localeID := "en"
+	if localeIDParam := c.Query("localeId"); localeIDParam != "" {
+		resolvedLocaleId, err := utils.ResolveLocaleId(localeIDParam)
+		if err != nil {
+			return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+		} else if resolvedLocaleId == nil {
+			return errorutil.Response(c, fiber.StatusBadRequest, errors.LocaleNotFound, "Locale not found.")
+		}
+		localeID = *resolvedLocaleId
+	}
+
+	// The upload is closed once the normalized CSV document is written, after this handler returns.
+	var body io.ReadCloser = io.NopCloser(bytes.NewReader(c.Body()))
+	if strings.HasPrefix(c.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			return errorutil.Response(c, fiber.StatusBadRequest, errorutil.MissingRequiredParam, "file is required.")
+		}
+		if body, err = fileHeader.Open(); err != nil {
+			return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.InternalServerError, err.Error())
+		}
+	}
+
+	document, err := services.NewPhoneNumberCSV(body, defaultRegion, localeID)
+	if err != nil {
+		_ = body.Close()
+	}
+	if goerrors.Is(err, services.ErrInvalidPhoneNumberCSV) {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.InvalidPhoneNumberCSV, "CSV document needs a header with a phoneNumber column.")
+	} else if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.InternalServerError, err.Error())
+	}
+
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	c.Attachment("phone-numbers.csv")
+	c.Status(fiber.StatusOK).Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer body.Close()
+		if err := document.Write(w, w.Flush); err != nil {
+			log.Errorf("Failed to write phone number CSV: %v", err)
+		}
+	})
+
+	return nil
+}
+
+// invalidPhoneNumberMessage returns the message of a phone number that can't be parsed.
+func invalidPhoneNumberMessage(territoryID string) string {
+	if territoryID == "" {
+		return "phoneNumber must be an international phone number like +31612345678 without territoryId."
+	}
+
+	return "phoneNumber must be a phone number."
+}
+
+// phoneLookupParams parses the required locale and optional metadata flag of the phone code lookups.
+// An invalid parameter returns a response error.
+func phoneLookupParams(c *fiber.Ctx) (string, bool, error) {
+	localeIDParam := c.Query("localeId")
+	if localeIDParam == "" {
+		return "", false, newResponseError(fiber.StatusBadRequest, errorutil.InvalidParam, "localeId query parameter is required.")
+	}
+
+	// Resolve the locale id for backwards compatibility.
+	resolvedLocaleId, err := utils.ResolveLocaleId(localeIDParam)
+	if err != nil {
+		return "", false, err
+	} else if resolvedLocaleId == nil {
+		return "", false, newResponseError(fiber.StatusBadRequest, errors.LocaleNotFound, "Locale not found.")
+	}
+
+	withMetadata := false
+	if c.Query("metadata") != "" {
+		value, err := strconv.ParseBool(c.Query("metadata"))
+		if err != nil {
+			return "", false, newResponseError(fiber.StatusBadRequest, errorutil.InvalidParam, "metadata must be true or false.")
+		}
+		withMetadata = value
+	}
+
+	return *resolvedLocaleId, withMetadata, nil
+}
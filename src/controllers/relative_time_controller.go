@@ -0,0 +1,65 @@
+package controllers
+
+import (
+	"api-i18n/main/src/dto/responses"
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/errors"
+	"api-i18n/main/src/services"
+	goerrors "errors"
+
+	errorutil "github.com/ArnoldPMolenaar/api-utils/errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// FormatRelativeTime func for formatting a relative duration like -3 days for a locale.
+func FormatRelativeTime(c *fiber.Ctx) error {
+	value := c.Query("value")
+	if value == "" {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "value query parameter is required.")
+	}
+
+	unit := c.Query("unit")
+	if unit == "" {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "unit query parameter is required.")
+	}
+
+	width := enums.WIDTH_LONG
+	if styleParam := c.Query("style"); styleParam != "" {
+		width = ""
+		width.Convert(styleParam)
+		if width == "" {
+			return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "style must be long, short or narrow.")
+		}
+	}
+
+	numeric := true
+	switch c.Query("numeric", "always") {
+	case "always":
+	case "auto":
+		numeric = false
+	default:
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "numeric must be always or auto.")
+	}
+
+	localeID, err := localeParam(c)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+
+	formatted, relativeTimeFormat, err := services.FormatRelativeTime(localeID, value, unit, width, numeric)
+	if err != nil {
+		switch {
+		case goerrors.Is(err, services.ErrInvalidNumber):
+			return errorutil.Response(c, fiber.StatusBadRequest, errors.InvalidNumber, "Value is invalid.")
+		case goerrors.Is(err, services.ErrRelativeTimeFormatNotFound):
+			return errorutil.Response(c, fiber.StatusNotFound, errors.RelativeTimeFormatNotFound, "Relative time format of locale and unit not found.")
+		default:
+			return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+		}
+	}
+
+	response := responses.FormattedRelativeTime{}
+	response.SetFormattedRelativeTime(relativeTimeFormat.LocaleID, relativeTimeFormat.Unit, relativeTimeFormat.DisplayName, relativeTimeFormat.Width.String(), value, formatted)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
@@ -0,0 +1,35 @@
+package controllers
+
+import (
+	goerrors "errors"
+
+	errorutil "github.com/ArnoldPMolenaar/api-utils/errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// responseError is a request error with the status, code and message of its error response.
+// Helpers that parse the request return it, so the handler sends the error response once.
+type responseError struct {
+	status  int
+	code    string
+	message string
+}
+
+// newResponseError returns a request error with the status, code and message of its error response.
+func newResponseError(status int, code, message string) *responseError {
+	return &responseError{status: status, code: code, message: message}
+}
+
+func (e *responseError) Error() string {
+	return e.message
+}
+
+// errorResponse sends the error response of a request error; other errors are query errors.
+func errorResponse(c *fiber.Ctx, err error) error {
+	var requestErr *responseError
+	if goerrors.As(err, &requestErr) {
+		return errorutil.Response(c, requestErr.status, requestErr.code, requestErr.message)
+	}
+
+	return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+}
@@ -0,0 +1,129 @@
+package controllers
+
+import (
+	"api-i18n/main/src/dto/requests"
+	"api-i18n/main/src/dto/responses"
+	"api-i18n/main/src/errors"
+	"api-i18n/main/src/services"
+	goerrors "errors"
+	"io"
+
+	errorutil "github.com/ArnoldPMolenaar/api-utils/errors"
+	util "github.com/ArnoldPMolenaar/api-utils/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetKeyScreenshots func for getting the screenshots of a key.
+func GetKeyScreenshots(c *fiber.Ctx) error {
+	keyID, err := util.StringToUint(c.Params("id"))
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, err.Error())
+	}
+
+	// Check if the key exists.
+	key, err := services.GetKeyByID(keyID)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if key.ID == 0 {
+		return errorutil.Response(c, fiber.StatusNotFound, errors.KeyExists, "Key does not exist.")
+	}
+
+	screenshots, err := services.GetKeyScreenshots(key.ID)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	response := responses.KeyScreenshotList{}
+	response.SetKeyScreenshotList(screenshots)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// CreateKeyScreenshot func for uploading a screenshot to a key.
+// The image is read from the "file" form field, the highlighted region from the region form fields.
+func CreateKeyScreenshot(c *fiber.Ctx) error {
+	keyID, err := util.StringToUint(c.Params("id"))
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, err.Error())
+	}
+
+	// Create a new screenshot struct for the request.
+	screenshotRequest := &requests.CreateKeyScreenshot{}
+
+	// Check, if received form data is parsed.
+	if err := c.BodyParser(screenshotRequest); err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.BodyParse, err.Error())
+	}
+
+	// Validate screenshot fields.
+	validate := util.NewValidator()
+	if err := validate.Struct(screenshotRequest); err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.Validator, util.ValidatorErrors(err))
+	} else if screenshotRequest.HasPartialRegion() {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.InvalidScreenshot, "Region requires regionX, regionY, regionWidth and regionHeight.")
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.MissingRequiredParam, "file is required.")
+	}
+
+	// Check if the key exists.
+	key, err := services.GetKeyByID(keyID)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if key.ID == 0 {
+		return errorutil.Response(c, fiber.StatusNotFound, errors.KeyExists, "Key does not exist.")
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.BodyParse, err.Error())
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.BodyParse, err.Error())
+	}
+
+	// Create the screenshot.
+	screenshot, err := services.CreateKeyScreenshot(key.ID, fileHeader.Filename, data, *screenshotRequest)
+	if goerrors.Is(err, services.ErrInvalidScreenshot) {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.InvalidScreenshot, err.Error())
+	} else if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.InternalServerError, err.Error())
+	}
+
+	response := responses.KeyScreenshot{}
+	response.SetKeyScreenshot(screenshot)
+
+	return c.Status(fiber.StatusCreated).JSON(response)
+}
+
+// DeleteKeyScreenshot func for deleting a screenshot of a key.
+func DeleteKeyScreenshot(c *fiber.Ctx) error {
+	keyID, err := util.StringToUint(c.Params("id"))
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, err.Error())
+	}
+	screenshotID, err := util.StringToUint(c.Params("screenshotId"))
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, err.Error())
+	}
+
+	// Find the screenshot.
+	screenshot, err := services.GetKeyScreenshotByID(keyID, screenshotID)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if screenshot.ID == 0 {
+		return errorutil.Response(c, fiber.StatusNotFound, errors.ScreenshotExists, "Screenshot does not exist.")
+	}
+
+	// Delete the screenshot.
+	if err := services.DeleteKeyScreenshot(screenshot); err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.InternalServerError, err.Error())
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,43 @@
+package controllers
+
+import (
+	"api-i18n/main/src/dto/responses"
+	"api-i18n/main/src/errors"
+	"api-i18n/main/src/services"
+	"api-i18n/main/src/utils"
+
+	errorutil "github.com/ArnoldPMolenaar/api-utils/errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetScriptLookup func for getting script lookup by locale ID and optional name filter.
+func GetScriptLookup(c *fiber.Ctx) error {
+	localeIDParam := c.Query("localeId")
+	if localeIDParam == "" {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "localeId query parameter is required.")
+	}
+
+	nameParam := c.Query("name")
+	var name *string
+	if nameParam != "" {
+		name = &nameParam
+	}
+
+	// Resolve the locale id for backwards compatibility.
+	resolvedLocaleId, err := utils.ResolveLocaleId(localeIDParam)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if resolvedLocaleId == nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.LocaleNotFound, "Locale not found.")
+	}
+
+	scripts, err := services.GetScriptsLookup(*resolvedLocaleId, name)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	response := responses.ScriptLookupList{}
+	response.SetScriptLookupList(scripts)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
@@ -4,14 +4,16 @@ import (
 	"api-i18n/main/src/dto/responses"
 	"api-i18n/main/src/enums"
 	"api-i18n/main/src/errors"
+	"api-i18n/main/src/models"
 	"api-i18n/main/src/services"
 	"api-i18n/main/src/utils"
+	"strings"
 
 	errorutil "github.com/ArnoldPMolenaar/api-utils/errors"
 	"github.com/gofiber/fiber/v2"
 )
 
-// GetTerritoryLookup func for getting territory lookup by locale ID, type and optional name filter.
+// GetTerritoryLookup func for getting territory lookup by locale ID, type, optional containing region and optional name filter.
 func GetTerritoryLookup(c *fiber.Ctx) error {
 	localeIDParam := c.Query("localeId")
 	if localeIDParam == "" {
@@ -32,13 +34,151 @@ func GetTerritoryLookup(c *fiber.Ctx) error {
 		t = &tt
 	}
 
+	containedInParam := strings.ToUpper(c.Query("containedIn"))
+	var containedIn *string
+	if containedInParam != "" {
+		if available, err := services.IsTerritoryAvailable(containedInParam); err != nil {
+			return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+		} else if !available {
+			return errorutil.Response(c, fiber.StatusBadRequest, errors.TerritoryNotFound, "containedIn territory not found.")
+		}
+		containedIn = &containedInParam
+	}
+
 	// Resolve the locale id for backwards compatibility.
-	resolvedLocaleId := utils.ResolveLocaleId(localeIDParam)
-	if resolvedLocaleId == nil {
+	resolvedLocaleId, err := utils.ResolveLocaleId(localeIDParam)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if resolvedLocaleId == nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.LocaleNotFound, "Locale not found.")
+	}
+
+	territories, err := services.GetTerritoriesLookup(*resolvedLocaleId, t, containedIn, name)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	response := responses.TerritoryLookupList{}
+	response.SetTerritoryLookupList(territories)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// ConvertTerritoryCode func for getting the alpha-2, alpha-3, numeric code and flag of a territory code in any form.
+// Deprecated codes are mapped to their current territory.
+func ConvertTerritoryCode(c *fiber.Ctx) error {
+	code := c.Query("code")
+	if code == "" {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "code query parameter is required.")
+	}
+
+	territoryCode, alias, err := services.ConvertTerritoryCode(code)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if territoryCode.TerritoryID == "" {
+		return errorutil.Response(c, fiber.StatusNotFound, errors.TerritoryNotFound, "Territory not found.")
+	}
+
+	response := responses.TerritoryCodeConversion{}
+	response.SetTerritoryCodeConversion(code, territoryCode, alias)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// GetTerritory func for getting the languages, currencies, week data and measurement data of a territory.
+func GetTerritory(c *fiber.Ctx) error {
+	territoryID := strings.ToUpper(c.Params("id"))
+	if available, err := services.IsTerritoryAvailable(territoryID); err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if !available {
+		return errorutil.Response(c, fiber.StatusNotFound, errors.TerritoryNotFound, "Territory not found.")
+	}
+
+	territoryInfo, err := services.GetTerritoryInfo(territoryID)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if territoryInfo.TerritoryID == "" {
+		return errorutil.Response(c, fiber.StatusNotFound, errors.TerritoryNotFound, "Territory info not found.")
+	}
+
+	response := responses.TerritoryInfo{}
+	response.SetTerritoryInfo(territoryInfo)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// GetTerritoryChildren func for getting the territories a region directly contains, with localized names.
+func GetTerritoryChildren(c *fiber.Ctx) error {
+	return getTerritoryHierarchy(c, services.GetTerritoryChildren)
+}
+
+// GetTerritoryAncestors func for getting the regions and groupings that contain a territory, with localized names.
+func GetTerritoryAncestors(c *fiber.Ctx) error {
+	return getTerritoryHierarchy(c, services.GetTerritoryAncestors)
+}
+
+// GetTerritorySubdivisions func for getting the ISO 3166-2 subdivisions of a territory with localized names and optional name filter.
+func GetTerritorySubdivisions(c *fiber.Ctx) error {
+	localeIDParam := c.Query("localeId")
+	if localeIDParam == "" {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "localeId query parameter is required.")
+	}
+
+	nameParam := c.Query("name")
+	var name *string
+	if nameParam != "" {
+		name = &nameParam
+	}
+
+	territoryID := strings.ToUpper(c.Params("id"))
+	if available, err := services.IsTerritoryAvailable(territoryID); err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if !available {
+		return errorutil.Response(c, fiber.StatusNotFound, errors.TerritoryNotFound, "Territory not found.")
+	}
+
+	// Resolve the locale id for backwards compatibility.
+	resolvedLocaleId, err := utils.ResolveLocaleId(localeIDParam)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if resolvedLocaleId == nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.LocaleNotFound, "Locale not found.")
+	}
+
+	subdivisions, err := services.GetSubdivisionsLookup(*resolvedLocaleId, territoryID, name)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	response := responses.SubdivisionLookupList{}
+	response.SetSubdivisionLookupList(subdivisions)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// getTerritoryHierarchy gets the related territories of the territory in the path with the given service.
+func getTerritoryHierarchy(c *fiber.Ctx, related func(localeID, territoryID string) (*[]models.TerritoryName, error)) error {
+	localeIDParam := c.Query("localeId")
+	if localeIDParam == "" {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "localeId query parameter is required.")
+	}
+
+	territoryID := strings.ToUpper(c.Params("id"))
+	if available, err := services.IsTerritoryAvailable(territoryID); err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if !available {
+		return errorutil.Response(c, fiber.StatusNotFound, errors.TerritoryNotFound, "Territory not found.")
+	}
+
+	// Resolve the locale id for backwards compatibility.
+	resolvedLocaleId, err := utils.ResolveLocaleId(localeIDParam)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if resolvedLocaleId == nil {
 		return errorutil.Response(c, fiber.StatusBadRequest, errors.LocaleNotFound, "Locale not found.")
 	}
 
-	territories, err := services.GetTerritoriesLookup(*resolvedLocaleId, t, name)
+	territories, err := related(*resolvedLocaleId, territoryID)
 	if err != nil {
 		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
 	}
@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	"api-i18n/main/src/dto/responses"
+	"api-i18n/main/src/errors"
+	"api-i18n/main/src/services"
+	"api-i18n/main/src/utils"
+	"strings"
+	"time"
+
+	errorutil "github.com/ArnoldPMolenaar/api-utils/errors"
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetTimeZoneLookup func for getting time zone lookup by locale ID, optional territory and optional name filter.
+func GetTimeZoneLookup(c *fiber.Ctx) error {
+	localeIDParam := c.Query("localeId")
+	if localeIDParam == "" {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "localeId query parameter is required.")
+	}
+
+	nameParam := c.Query("name")
+	var name *string
+	if nameParam != "" {
+		name = &nameParam
+	}
+
+	territoryParam := strings.ToUpper(c.Query("territory"))
+	var territoryID *string
+	if territoryParam != "" {
+		territoryID = &territoryParam
+	}
+
+	// Resolve the locale id for backwards compatibility.
+	resolvedLocaleId, err := utils.ResolveLocaleId(localeIDParam)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if resolvedLocaleId == nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.LocaleNotFound, "Locale not found.")
+	}
+
+	timeZones, err := services.GetTimeZonesLookup(*resolvedLocaleId, territoryID, name)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	response := responses.TimeZoneLookupList{}
+	response.SetTimeZoneLookupList(timeZones, time.Now())
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
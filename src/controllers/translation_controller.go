@@ -4,6 +4,7 @@ import (
 	"api-i18n/main/src/errors"
 	"api-i18n/main/src/services"
 	"api-i18n/main/src/utils"
+	"strconv"
 
 	errorutil "github.com/ArnoldPMolenaar/api-utils/errors"
 	"github.com/gofiber/fiber/v2"
@@ -22,9 +23,16 @@ func GetTranslationsByLocaleId(c *fiber.Ctx) error {
 		return errorutil.Response(c, fiber.StatusBadRequest, errors.AppNotFound, "App not found.")
 	}
 
+	// Generate pseudo-locales on the fly from the app source locale.
+	if services.IsPseudoLocale(localeId) {
+		return getPseudoTranslations(c, appName, localeId)
+	}
+
 	// Resolve the locale id for backwards compatibility.
-	resolvedLocaleId := utils.ResolveLocaleId(localeId)
-	if resolvedLocaleId == nil {
+	resolvedLocaleId, err := utils.ResolveLocaleId(localeId)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if resolvedLocaleId == nil {
 		return errorutil.Response(c, fiber.StatusBadRequest, errors.LocaleNotFound, "Locale not found.")
 	}
 
@@ -43,3 +51,30 @@ func GetTranslationsByLocaleId(c *fiber.Ctx) error {
 
 	return c.Status(fiber.StatusOK).JSON(translations)
 }
+
+// getPseudoTranslations func for generating the translations of a pseudo-locale from the app source locale.
+// The optional expansion query parameter sets the percentage of extra characters (0-300).
+func getPseudoTranslations(c *fiber.Ctx, appName, localeId string) error {
+	var expansion *int
+	if expansionParam := c.Query("expansion"); expansionParam != "" {
+		value, err := strconv.Atoi(expansionParam)
+		if err != nil || value < 0 || value > 300 {
+			return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, "expansion must be a number between 0 and 300.")
+		}
+		expansion = &value
+	}
+
+	sourceLocaleID, err := services.GetAppSourceLocaleID(appName)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if sourceLocaleID == nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.SourceLocaleNotFound, "Source locale not set in app.")
+	}
+
+	translations, err := services.GetPseudoTranslations(appName, *sourceLocaleID, localeId, expansion)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	return c.Status(fiber.StatusOK).JSON(translations)
+}
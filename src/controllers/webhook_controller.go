@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"api-i18n/main/src/dto/requests"
+	"api-i18n/main/src/dto/responses"
+	"api-i18n/main/src/errors"
+	"api-i18n/main/src/services"
+
+	errorutil "github.com/ArnoldPMolenaar/api-utils/errors"
+	util "github.com/ArnoldPMolenaar/api-utils/utils"
+	"github.com/gofiber/fiber/v2"
+	"github.com/samber/lo"
+)
+
+// GetWebhooks func for getting the webhooks of an app.
+func GetWebhooks(c *fiber.Ctx) error {
+	appName := c.Query("app")
+
+	// Check if the app exists.
+	appAvailable, err := services.IsAppAvailable(appName)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if !appAvailable {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.AppNotFound, "App not found.")
+	}
+
+	webhooks, err := services.GetWebhooks(appName)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	response := responses.WebhookList{}
+	response.SetWebhookList(webhooks)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// CreateWebhook func for creating a webhook.
+func CreateWebhook(c *fiber.Ctx) error {
+	// Create a new webhook struct for the request.
+	webhookRequest := &requests.CreateWebhook{}
+
+	// Check, if received JSON data is parsed.
+	if err := c.BodyParser(webhookRequest); err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.BodyParse, err.Error())
+	}
+
+	// Validate webhook fields.
+	validate := util.NewValidator()
+	if err := validate.Struct(webhookRequest); err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.Validator, util.ValidatorErrors(err))
+	}
+
+	// Check if the events can be subscribed to.
+	webhookRequest.Events = lo.Uniq(webhookRequest.Events)
+	for _, event := range webhookRequest.Events {
+		if !services.IsWebhookEvent(event) {
+			return errorutil.Response(c, fiber.StatusBadRequest, errors.InvalidWebhookEvent, "Unknown webhook event "+event+".")
+		}
+	}
+
+	// Check if the app exists.
+	appAvailable, err := services.IsAppAvailable(webhookRequest.AppName)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if !appAvailable {
+		return errorutil.Response(c, fiber.StatusBadRequest, errors.AppNotFound, "App not found.")
+	}
+
+	webhook, err := services.CreateWebhook(*webhookRequest)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	response := responses.Webhook{}
+	response.SetWebhook(webhook)
+
+	return c.Status(fiber.StatusCreated).JSON(response)
+}
+
+// DeleteWebhook func for deleting a webhook.
+func DeleteWebhook(c *fiber.Ctx) error {
+	// Get the ID from the URL.
+	id, err := util.StringToUint(c.Params("id"))
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusBadRequest, errorutil.InvalidParam, err.Error())
+	}
+
+	// Find the webhook.
+	webhook, err := services.GetWebhookByID(id)
+	if err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	} else if webhook.ID == 0 {
+		return errorutil.Response(c, fiber.StatusNotFound, errors.WebhookExists, "Webhook does not exist.")
+	}
+
+	if err := services.DeleteWebhook(webhook.ID); err != nil {
+		return errorutil.Response(c, fiber.StatusInternalServerError, errorutil.QueryError, err.Error())
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -26,6 +26,16 @@ func Migrate(db *gorm.DB) error {
 		return tx.Error
 	}
 
+	// Adds the plural type enum type to the database.
+	if tx := db.Exec(`DO $$ 
+	BEGIN 
+		IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'plural_type') THEN 
+			CREATE TYPE plural_type AS ENUM ('cardinal', 'ordinal'); 
+		END IF; 
+	END $$;`); tx.Error != nil {
+		return tx.Error
+	}
+
 	// Adds the region type enum type to the database.
 	if tx := db.Exec(`DO $$ 
 	BEGIN 
@@ -37,11 +47,21 @@ func Migrate(db *gorm.DB) error {
 	}
 
 	// Updated migration set: normalized models + existing domain models.
-	err := db.AutoMigrate(&models.Language{}, &models.Script{}, &models.Territory{}, &models.Variant{}, &models.Locale{}, &models.LocaleName{}, &models.ScriptName{}, &models.TerritoryName{}, &models.VariantName{}, &models.App{}, &models.Category{}, &models.Key{}, &models.KeyTranslation{})
+	err := db.AutoMigrate(&models.Language{}, &models.Script{}, &models.Territory{}, &models.Variant{}, &models.Locale{}, &models.LocaleName{}, &models.ScriptName{}, &models.TerritoryName{}, &models.VariantName{}, &models.App{}, &models.Category{}, &models.Key{}, &models.KeyTranslation{}, &models.GlossaryTerm{}, &models.GlossaryTermTranslation{}, &models.KeyScreenshot{}, &models.KeyLengthLimit{}, &models.KeyComment{}, &models.KeyCommentMention{}, &models.Webhook{}, &models.PluralRule{}, &models.NumberingSystem{}, &models.NumberFormat{}, &models.Currency{}, &models.CurrencyName{}, &models.CalendarFormat{}, &models.RelativeTimeFormat{}, &models.ListPattern{}, &models.Metazone{}, &models.MetazoneName{}, &models.TimeZone{}, &models.TimeZoneName{}, &models.TerritoryContainment{}, &models.TerritoryInfo{}, &models.TerritoryLanguage{}, &models.TerritoryCurrency{}, &models.LikelySubtag{}, &models.LocaleAlias{}, &models.LocaleDisplayPattern{}, &models.ScriptLanguage{}, &models.LocaleMetadata{}, &models.Subdivision{}, &models.SubdivisionName{}, &models.TerritoryCode{})
 	if err != nil {
 		return err
 	}
 
+	// Adds the text search indexes of the key search.
+	if err := migrateSearchIndexes(db); err != nil {
+		return err
+	}
+
+	// Glossary terms are deleted permanently; purge the soft deleted ones, as they block the unique term index.
+	if tx := db.Unscoped().Where("deleted_at IS NOT NULL").Delete(&models.GlossaryTerm{}); tx.Error != nil {
+		return tx.Error
+	}
+
 	// -- Start CLDR script migration --
 	if err := seedCLDRData(db); err != nil {
 		return err
@@ -72,6 +92,49 @@ func readJSONFile(path string) (map[string]interface{}, error) {
 	return doc, nil
 }
 
+// jsonObject walks the nested objects of a JSON document by their keys.
+// Returns nil when a key does not exist or its value is not an object.
+func jsonObject(doc map[string]interface{}, keys ...string) map[string]interface{} {
+	current := doc
+	for _, key := range keys {
+		next, ok := current[key].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = next
+	}
+
+	return current
+}
+
+// jsonString returns the string value of a key in a JSON object, or an empty string.
+func jsonString(object map[string]interface{}, key string) string {
+	value, _ := object[key].(string)
+	return value
+}
+
+// jsonStrings returns the string values of a JSON object; other values are skipped.
+func jsonStrings(object map[string]interface{}) map[string]string {
+	values := make(map[string]string, len(object))
+	for key, value := range object {
+		if s, ok := value.(string); ok {
+			values[key] = s
+		}
+	}
+
+	return values
+}
+
+// seededLocaleIDs returns the IDs of the seeded locales.
+func seededLocaleIDs(db *gorm.DB) ([]string, error) {
+	localeIDs := make([]string, 0)
+	if tx := db.Model(&models.Locale{}).Order("id").Pluck("id", &localeIDs); tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return localeIDs, nil
+}
+
 // isNumeric checks if a string consists only of numeric characters.
 func isNumeric(s string) bool {
 	for _, r := range s {
@@ -82,10 +145,76 @@ func isNumeric(s string) bool {
 	return len(s) > 0
 }
 
-// seedCLDRData seeds the database with CLDR data for languages, scripts, territories, variants, locales, and their names.
+// cldrBasePath is the path of the cldr-json submodule.
+const cldrBasePath = "src/database/fixtures/cldr-json/cldr-json/"
+
+// seedCLDRData seeds the database with CLDR data. Every data set is skipped when it is already seeded.
 func seedCLDRData(db *gorm.DB) error {
-	const cldrBasePath = "src/database/fixtures/cldr-json/cldr-json/"
+	if err := seedCLDRLocales(db); err != nil {
+		return err
+	}
+
+	if err := seedCLDRPluralRules(db); err != nil {
+		return err
+	}
+
+	if err := seedCLDRNumbers(db); err != nil {
+		return err
+	}
+
+	if err := seedCLDRDates(db); err != nil {
+		return err
+	}
+
+	if err := seedCLDRRelativeTimes(db); err != nil {
+		return err
+	}
+
+	if err := seedCLDRListPatterns(db); err != nil {
+		return err
+	}
+
+	if err := seedCLDRTimeZones(db); err != nil {
+		return err
+	}
+
+	if err := seedCLDRTerritoryContainment(db); err != nil {
+		return err
+	}
+
+	if err := seedCLDRTerritoryInfo(db); err != nil {
+		return err
+	}
+
+	if err := seedCLDRLikelySubtags(db); err != nil {
+		return err
+	}
+
+	if err := seedCLDRLocaleDisplayPatterns(db); err != nil {
+		return err
+	}
+
+	if err := seedCLDRScripts(db); err != nil {
+		return err
+	}
+
+	if err := seedCLDRLocaleMetadata(db); err != nil {
+		return err
+	}
+
+	if err := seedCLDRSubdivisions(db); err != nil {
+		return err
+	}
+
+	if err := seedCLDRTerritoryCodes(db); err != nil {
+		return err
+	}
+
+	return nil
+}
 
+// seedCLDRLocales seeds the database with CLDR data for languages, scripts, territories, variants, locales, and their names.
+func seedCLDRLocales(db *gorm.DB) error {
 	var languageCount, scriptCount, territoryCount, variantCount, localeCount, scriptNameCount, territoryNameCount, variantNameCount, localeNameCount int64
 	_ = db.Model(&models.Language{}).Count(&languageCount)
 	_ = db.Model(&models.Script{}).Count(&scriptCount)
@@ -0,0 +1,75 @@
+package database
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// SearchConfigs maps language subtags to the Postgres text search configuration used for their values.
+// Languages without a dedicated configuration fall back to "simple".
+var SearchConfigs = map[string]string{
+	"ar": "arabic",
+	"da": "danish",
+	"de": "german",
+	"el": "greek",
+	"en": "english",
+	"es": "spanish",
+	"fi": "finnish",
+	"fr": "french",
+	"ga": "irish",
+	"hu": "hungarian",
+	"id": "indonesian",
+	"it": "italian",
+	"lt": "lithuanian",
+	"nb": "norwegian",
+	"ne": "nepali",
+	"nl": "dutch",
+	"nn": "norwegian",
+	"no": "norwegian",
+	"pt": "portuguese",
+	"ro": "romanian",
+	"ru": "russian",
+	"sv": "swedish",
+	"ta": "tamil",
+	"tr": "turkish",
+}
+
+// SearchConfigExpression returns a SQL expression that resolves the text search configuration
+// of the locale in the given column. Every configuration is a regconfig constant, so the expression
+// is immutable and can be indexed; queries must use the same expression to use the index.
+func SearchConfigExpression(column string) string {
+	languages := make([]string, 0, len(SearchConfigs))
+	for language := range SearchConfigs {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("(CASE split_part(%s, '-', 1)", column))
+	for _, language := range languages {
+		builder.WriteString(fmt.Sprintf(" WHEN '%s' THEN '%s'::regconfig", language, SearchConfigs[language]))
+	}
+	builder.WriteString(" ELSE 'simple'::regconfig END)")
+
+	return builder.String()
+}
+
+// migrateSearchIndexes creates the GIN indexes of the text search documents of keys and translation values.
+func migrateSearchIndexes(db *gorm.DB) error {
+	indexes := []string{
+		"CREATE INDEX IF NOT EXISTS idx_keys_name_search ON keys USING GIN (to_tsvector('simple'::regconfig, name))",
+		"CREATE INDEX IF NOT EXISTS idx_keys_description_search ON keys USING GIN (to_tsvector('simple'::regconfig, description))",
+		fmt.Sprintf("CREATE INDEX IF NOT EXISTS idx_key_translations_value_search ON key_translations USING GIN (to_tsvector(%s, value))", SearchConfigExpression("locale_id")),
+	}
+
+	for _, index := range indexes {
+		if tx := db.Exec(index); tx.Error != nil {
+			return tx.Error
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package database
+
+import (
+	"api-i18n/main/src/models"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+// seedCLDRDates seeds the Gregorian calendar patterns and names and the localized GMT format of cldr-dates-full.
+func seedCLDRDates(db *gorm.DB) error {
+	var calendarFormatCount int64
+	_ = db.Model(&models.CalendarFormat{}).Count(&calendarFormatCount)
+	if calendarFormatCount > 0 {
+		return nil // Data already seeded; skip.
+	}
+
+	localeIDs, err := seededLocaleIDs(db)
+	if err != nil {
+		return err
+	}
+
+	calendarFormats := make([]models.CalendarFormat, 0)
+	for _, locale := range localeIDs {
+		gregorianDoc, err := readJSONFile(cldrBasePath + "cldr-dates-full/main/" + locale + "/ca-gregorian.json")
+		if err != nil {
+			continue
+		}
+
+		gregorian := jsonObject(gregorianDoc, "main", locale, "dates", "calendars", "gregorian")
+		if gregorian == nil {
+			continue
+		}
+
+		eras := make(map[string]map[string]string)
+		for width := range jsonObject(gregorian, "eras") {
+			eras[width] = jsonStrings(jsonObject(gregorian, "eras", width))
+		}
+
+		// Locales without time zone names use the localized GMT format of root.
+		gmtFormat, gmtZeroFormat, hourFormat := models.DefaultGMTFormat, models.DefaultGMTZeroFormat, models.DefaultHourFormat
+		if namesDoc, err := readJSONFile(cldrBasePath + "cldr-dates-full/main/" + locale + "/timeZoneNames.json"); err == nil {
+			names := jsonObject(namesDoc, "main", locale, "dates", "timeZoneNames")
+			if value := jsonString(names, "gmtFormat"); value != "" {
+				gmtFormat = value
+			}
+			if value := jsonString(names, "gmtZeroFormat"); value != "" {
+				gmtZeroFormat = value
+			}
+			if value := jsonString(names, "hourFormat"); value != "" {
+				hourFormat = value
+			}
+		}
+
+		calendarFormats = append(calendarFormats, models.CalendarFormat{
+			LocaleID:         locale,
+			DateFormats:      jsonStrings(jsonObject(gregorian, "dateFormats")),
+			TimeFormats:      jsonStrings(jsonObject(gregorian, "timeFormats")),
+			DateTimeFormats:  jsonStrings(jsonObject(gregorian, "dateTimeFormats")),
+			AvailableFormats: jsonStrings(jsonObject(gregorian, "dateTimeFormats", "availableFormats")),
+			Months:           calendarNames(jsonObject(gregorian, "months")),
+			Days:             calendarNames(jsonObject(gregorian, "days")),
+			Quarters:         calendarNames(jsonObject(gregorian, "quarters")),
+			DayPeriods:       calendarNames(jsonObject(gregorian, "dayPeriods")),
+			Eras:             eras,
+			GMTFormat:        gmtFormat,
+			GMTZeroFormat:    gmtZeroFormat,
+			HourFormat:       hourFormat,
+		})
+	}
+
+	if len(calendarFormats) > 0 {
+		log.Info("Inserting calendar formats...")
+		if tx := db.CreateInBatches(&calendarFormats, 100); tx.Error != nil {
+			return tx.Error
+		}
+	}
+
+	return nil
+}
+
+// calendarNames converts the context > width > key names of a calendar field.
+func calendarNames(field map[string]interface{}) models.CalendarNames {
+	names := make(models.CalendarNames)
+	for context := range field {
+		names[context] = make(map[string]map[string]string)
+		for width := range jsonObject(field, context) {
+			names[context][width] = jsonStrings(jsonObject(field, context, width))
+		}
+	}
+
+	return names
+}
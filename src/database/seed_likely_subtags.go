@@ -0,0 +1,71 @@
+package database
+
+import (
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+	"strings"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+// seedCLDRLikelySubtags seeds the likely subtags and the language, script, territory and variant aliases of cldr-core.
+func seedCLDRLikelySubtags(db *gorm.DB) error {
+	var likelySubtagCount, localeAliasCount int64
+	_ = db.Model(&models.LikelySubtag{}).Count(&likelySubtagCount)
+	_ = db.Model(&models.LocaleAlias{}).Count(&localeAliasCount)
+	if likelySubtagCount > 0 && localeAliasCount > 0 {
+		return nil // Data already seeded; skip.
+	}
+
+	likelySubtagsDoc, err := readJSONFile(cldrBasePath + "cldr-core/supplemental/likelySubtags.json")
+	if err != nil {
+		return err
+	}
+	aliasesDoc, err := readJSONFile(cldrBasePath + "cldr-core/supplemental/aliases.json")
+	if err != nil {
+		return err
+	}
+
+	likelySubtags := make([]models.LikelySubtag, 0)
+	for tag, maximized := range jsonStrings(jsonObject(likelySubtagsDoc, "supplemental", "likelySubtags")) {
+		likelySubtags = append(likelySubtags, models.LikelySubtag{
+			Tag:       strings.ReplaceAll(tag, "_", "-"),
+			Maximized: strings.ReplaceAll(maximized, "_", "-"),
+		})
+	}
+
+	localeAliases := make([]models.LocaleAlias, 0)
+	for _, aliasType := range []enums.AliasType{enums.ALIAS_LANGUAGE, enums.ALIAS_SCRIPT, enums.ALIAS_TERRITORY, enums.ALIAS_VARIANT} {
+		aliases := jsonObject(aliasesDoc, "supplemental", "metadata", "alias", aliasType.String()+"Alias")
+		for alias := range aliases {
+			replacement := jsonString(jsonObject(aliases, alias), "_replacement")
+			if replacement == "" {
+				continue
+			}
+			localeAliases = append(localeAliases, models.LocaleAlias{
+				Type:        aliasType,
+				Alias:       strings.ReplaceAll(alias, "_", "-"),
+				Replacement: strings.ReplaceAll(replacement, "_", "-"),
+				Reason:      jsonString(jsonObject(aliases, alias), "_reason"),
+			})
+		}
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if likelySubtagCount == 0 && len(likelySubtags) > 0 {
+			log.Info("Inserting likely subtags...")
+			if result := tx.CreateInBatches(&likelySubtags, 1000); result.Error != nil {
+				return result.Error
+			}
+		}
+		if localeAliasCount == 0 && len(localeAliases) > 0 {
+			log.Info("Inserting locale aliases...")
+			if result := tx.CreateInBatches(&localeAliases, 1000); result.Error != nil {
+				return result.Error
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,76 @@
+package database
+
+import (
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+	"strings"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+// seedCLDRListPatterns seeds the list patterns of cldr-misc-full.
+func seedCLDRListPatterns(db *gorm.DB) error {
+	var listPatternCount int64
+	_ = db.Model(&models.ListPattern{}).Count(&listPatternCount)
+	if listPatternCount > 0 {
+		return nil // Data already seeded; skip.
+	}
+
+	localeIDs, err := seededLocaleIDs(db)
+	if err != nil {
+		return err
+	}
+
+	listPatterns := make([]models.ListPattern, 0)
+	for _, locale := range localeIDs {
+		listDoc, err := readJSONFile(cldrBasePath + "cldr-misc-full/main/" + locale + "/listPatterns.json")
+		if err != nil {
+			continue
+		}
+
+		patterns := jsonObject(listDoc, "main", locale, "listPatterns")
+		for key := range patterns {
+			// The key is the type with an optional width suffix, e.g. listPattern-type-or-short.
+			name, found := strings.CutPrefix(key, "listPattern-type-")
+			if !found {
+				continue
+			}
+			typeName, widthName, _ := strings.Cut(name, "-")
+			if typeName == "standard" {
+				typeName = "and"
+			}
+			if widthName == "" {
+				widthName = "long"
+			}
+
+			var listType enums.ListType
+			var width enums.FormatWidth
+			listType.Convert(typeName)
+			width.Convert(widthName)
+			if listType == "" || width == "" {
+				continue
+			}
+
+			pattern := jsonObject(patterns, key)
+			listPatterns = append(listPatterns, models.ListPattern{
+				LocaleID: locale,
+				Type:     listType,
+				Width:    width,
+				Start:    jsonString(pattern, "start"),
+				Middle:   jsonString(pattern, "middle"),
+				End:      jsonString(pattern, "end"),
+				Two:      jsonString(pattern, "2"),
+			})
+		}
+	}
+
+	if len(listPatterns) > 0 {
+		log.Info("Inserting list patterns...")
+		if tx := db.CreateInBatches(&listPatterns, 500); tx.Error != nil {
+			return tx.Error
+		}
+	}
+
+	return nil
+}
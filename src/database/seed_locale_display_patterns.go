@@ -0,0 +1,61 @@
+package database
+
+import (
+	"api-i18n/main/src/models"
+	"strings"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+// seedCLDRLocaleDisplayPatterns seeds the locale display patterns and short language names of cldr-localenames-full.
+func seedCLDRLocaleDisplayPatterns(db *gorm.DB) error {
+	var localeDisplayPatternCount int64
+	_ = db.Model(&models.LocaleDisplayPattern{}).Count(&localeDisplayPatternCount)
+	if localeDisplayPatternCount > 0 {
+		return nil // Data already seeded; skip.
+	}
+
+	localeIDs, err := seededLocaleIDs(db)
+	if err != nil {
+		return err
+	}
+
+	localeDisplayPatterns := make([]models.LocaleDisplayPattern, 0)
+	for _, locale := range localeIDs {
+		displayNamesDoc, err := readJSONFile(cldrBasePath + "cldr-localenames-full/main/" + locale + "/localeDisplayNames.json")
+		if err != nil {
+			continue
+		}
+		pattern := jsonObject(displayNamesDoc, "main", locale, "localeDisplayNames", "localeDisplayPattern")
+		if pattern == nil {
+			continue
+		}
+
+		shortNames := make(map[string]string)
+		if languageNamesDoc, err := readJSONFile(cldrBasePath + "cldr-localenames-full/main/" + locale + "/languages.json"); err == nil {
+			for id, name := range jsonStrings(jsonObject(languageNamesDoc, "main", locale, "localeDisplayNames", "languages")) {
+				if languageID, found := strings.CutSuffix(id, "-alt-short"); found {
+					shortNames[languageID] = name
+				}
+			}
+		}
+
+		localeDisplayPatterns = append(localeDisplayPatterns, models.LocaleDisplayPattern{
+			LocaleID:       locale,
+			Pattern:        jsonString(pattern, "localePattern"),
+			Separator:      jsonString(pattern, "localeSeparator"),
+			KeyTypePattern: jsonString(pattern, "localeKeyTypePattern"),
+			ShortNames:     shortNames,
+		})
+	}
+
+	if len(localeDisplayPatterns) > 0 {
+		log.Info("Inserting locale display patterns...")
+		if tx := db.CreateInBatches(&localeDisplayPatterns, 500); tx.Error != nil {
+			return tx.Error
+		}
+	}
+
+	return nil
+}
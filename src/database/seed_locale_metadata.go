@@ -0,0 +1,102 @@
+package database
+
+import (
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+	"database/sql"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+// seedCLDRLocaleMetadata seeds the text direction of cldr-misc-full, the coverage levels and parent locales
+// of cldr-core and the default numbering system of the seeded number formats.
+func seedCLDRLocaleMetadata(db *gorm.DB) error {
+	var localeMetadataCount int64
+	_ = db.Model(&models.LocaleMetadata{}).Count(&localeMetadataCount)
+	if localeMetadataCount > 0 {
+		return nil // Data already seeded; skip.
+	}
+
+	localeIDs, err := seededLocaleIDs(db)
+	if err != nil {
+		return err
+	}
+
+	coverageLevels := make(map[string]string)
+	if coverageDoc, err := readJSONFile(cldrBasePath + "cldr-core/coverageLevels.json"); err == nil {
+		for _, key := range []string{"effectiveCoverageLevels", "coverageLevels"} {
+			for localeID, level := range jsonStrings(jsonObject(coverageDoc, key)) {
+				coverageLevels[strings.ReplaceAll(localeID, "_", "-")] = level
+			}
+		}
+	}
+
+	parentLocales := make(map[string]string)
+	if parentLocalesDoc, err := readJSONFile(cldrBasePath + "cldr-core/supplemental/parentLocales.json"); err == nil {
+		for localeID, parentID := range jsonStrings(jsonObject(parentLocalesDoc, "supplemental", "parentLocales", "parentLocale")) {
+			parentLocales[localeID] = parentID
+		}
+	}
+
+	defaultNumberFormats := make([]models.NumberFormat, 0)
+	if tx := db.Select("locale_id", "numbering_system_id").Find(&defaultNumberFormats, `"default" = ?`, true); tx.Error != nil {
+		return tx.Error
+	}
+	numberingSystems := make(map[string]string, len(defaultNumberFormats))
+	for _, numberFormat := range defaultNumberFormats {
+		numberingSystems[numberFormat.LocaleID] = numberFormat.NumberingSystemID
+	}
+
+	localeMetadata := make([]models.LocaleMetadata, 0, len(localeIDs))
+	for _, locale := range localeIDs {
+		metadata := models.LocaleMetadata{LocaleID: locale, Direction: enums.LTR}
+
+		if layoutDoc, err := readJSONFile(cldrBasePath + "cldr-misc-full/main/" + locale + "/layout.json"); err == nil {
+			if jsonString(jsonObject(layoutDoc, "main", locale, "layout", "orientation"), "characterOrder") == "right-to-left" {
+				metadata.Direction = enums.RTL
+			}
+		}
+
+		if level, ok := coverageLevels[locale]; ok {
+			metadata.CoverageLevel = sql.NullString{String: level, Valid: true}
+		}
+
+		// The parent is listed in parentLocales, or the locale without its last subtag.
+		parentID, ok := parentLocales[locale]
+		if !ok {
+			if i := strings.LastIndex(locale, "-"); i > 0 {
+				parentID = locale[:i]
+			}
+		}
+		if parentID != "" && parentID != "root" && slices.Contains(localeIDs, parentID) {
+			metadata.ParentLocaleID = sql.NullString{String: parentID, Valid: true}
+		}
+
+		// The default numbering system is inherited from the parent, e.g. de-CH uses the one of de.
+		for candidate := locale; candidate != ""; {
+			if numberingSystem, ok := numberingSystems[candidate]; ok {
+				metadata.NumberingSystemID = sql.NullString{String: numberingSystem, Valid: true}
+				break
+			}
+			i := strings.LastIndex(candidate, "-")
+			if i < 0 {
+				break
+			}
+			candidate = candidate[:i]
+		}
+
+		localeMetadata = append(localeMetadata, metadata)
+	}
+
+	if len(localeMetadata) > 0 {
+		log.Info("Inserting locale metadata...")
+		if tx := db.CreateInBatches(&localeMetadata, 500); tx.Error != nil {
+			return tx.Error
+		}
+	}
+
+	return nil
+}
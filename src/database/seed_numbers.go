@@ -0,0 +1,217 @@
+package database
+
+import (
+	"api-i18n/main/src/models"
+	"database/sql"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+// seedCLDRNumbers seeds the numbering systems and currency fraction digits of cldr-core and the number symbols,
+// number patterns and currency names of cldr-numbers-full.
+func seedCLDRNumbers(db *gorm.DB) error {
+	var numberingSystemCount, numberFormatCount, currencyCount, currencyNameCount int64
+	_ = db.Model(&models.NumberingSystem{}).Count(&numberingSystemCount)
+	_ = db.Model(&models.NumberFormat{}).Count(&numberFormatCount)
+	_ = db.Model(&models.Currency{}).Count(&currencyCount)
+	_ = db.Model(&models.CurrencyName{}).Count(&currencyNameCount)
+
+	if numberingSystemCount > 0 && numberFormatCount > 0 && currencyCount > 0 && currencyNameCount > 0 {
+		return nil // Data already seeded; skip.
+	}
+
+	localeIDs, err := seededLocaleIDs(db)
+	if err != nil {
+		return err
+	}
+
+	// Numeric numbering systems.
+	numberingSystems := make([]models.NumberingSystem, 0)
+	numberingSystemsDoc, err := readJSONFile(cldrBasePath + "cldr-core/supplemental/numberingSystems.json")
+	if err != nil {
+		return err
+	}
+	for id, system := range jsonObject(numberingSystemsDoc, "supplemental", "numberingSystems") {
+		systemMap, ok := system.(map[string]interface{})
+		if !ok || jsonString(systemMap, "_type") != "numeric" || jsonString(systemMap, "_digits") == "" {
+			continue
+		}
+		numberingSystems = append(numberingSystems, models.NumberingSystem{ID: id, Digits: jsonString(systemMap, "_digits")})
+	}
+
+	// Currency fraction digits and rounding, for standard and cash amounts.
+	currencyDataDoc, err := readJSONFile(cldrBasePath + "cldr-core/supplemental/currencyData.json")
+	if err != nil {
+		return err
+	}
+	fractions := jsonObject(currencyDataDoc, "supplemental", "currencyData", "fractions")
+	currencies := make(map[string]models.Currency)
+	newCurrency := func(id string) models.Currency {
+		currency := models.Currency{ID: id, Digits: 2}
+		for _, key := range []string{"DEFAULT", id} {
+			if fraction := jsonObject(fractions, key); fraction != nil {
+				if digits, err := strconv.Atoi(jsonString(fraction, "_digits")); err == nil {
+					currency.Digits = digits
+				}
+				if rounding, err := strconv.Atoi(jsonString(fraction, "_rounding")); err == nil {
+					currency.Rounding = rounding
+				}
+				if digits, err := strconv.ParseInt(jsonString(fraction, "_cashDigits"), 10, 64); err == nil {
+					currency.CashDigits = sql.NullInt64{Int64: digits, Valid: true}
+				}
+				if rounding, err := strconv.ParseInt(jsonString(fraction, "_cashRounding"), 10, 64); err == nil {
+					currency.CashRounding = sql.NullInt64{Int64: rounding, Valid: true}
+				}
+			}
+		}
+		return currency
+	}
+
+	numberFormats := make([]models.NumberFormat, 0)
+	currencyNames := make([]models.CurrencyName, 0)
+
+	for _, locale := range localeIDs {
+		numbersDoc, err := readJSONFile(cldrBasePath + "cldr-numbers-full/main/" + locale + "/numbers.json")
+		if err == nil {
+			numberFormats = append(numberFormats, newNumberFormats(locale, jsonObject(numbersDoc, "main", locale, "numbers"))...)
+		}
+
+		currenciesDoc, err := readJSONFile(cldrBasePath + "cldr-numbers-full/main/" + locale + "/currencies.json")
+		if err != nil {
+			continue
+		}
+		for id, currency := range jsonObject(currenciesDoc, "main", locale, "numbers", "currencies") {
+			currencyMap, ok := currency.(map[string]interface{})
+			if !ok || len(id) != 3 {
+				continue
+			}
+			if _, ok := currencies[id]; !ok {
+				currencies[id] = newCurrency(id)
+			}
+
+			currencyName := models.CurrencyName{
+				CurrencyID:   id,
+				LocaleID:     locale,
+				Name:         jsonString(currencyMap, "displayName"),
+				Symbol:       jsonString(currencyMap, "symbol"),
+				NarrowSymbol: jsonString(currencyMap, "symbol-alt-narrow"),
+			}
+			if currencyName.Name == "" {
+				currencyName.Name = id
+			}
+			if currencyName.Symbol == "" {
+				currencyName.Symbol = id
+			}
+			if currencyName.NarrowSymbol == "" {
+				currencyName.NarrowSymbol = currencyName.Symbol
+			}
+			currencyNames = append(currencyNames, currencyName)
+		}
+	}
+
+	// Bulk insert collected data.
+
+	if numberingSystemCount == 0 && len(numberingSystems) > 0 {
+		log.Info("Inserting numbering systems...")
+		if tx := db.Create(&numberingSystems); tx.Error != nil {
+			return tx.Error
+		}
+	}
+
+	if numberFormatCount == 0 && len(numberFormats) > 0 {
+		log.Info("Inserting number formats...")
+		systemIDs := make(map[string]bool, len(numberingSystems))
+		for i := range numberingSystems {
+			systemIDs[numberingSystems[i].ID] = true
+		}
+		for i := range numberFormats {
+			if !systemIDs[numberFormats[i].NumberingSystemID] {
+				continue
+			}
+			if tx := db.Create(&numberFormats[i]); tx.Error != nil {
+				return tx.Error
+			}
+		}
+	}
+
+	if currencyCount == 0 && len(currencies) > 0 {
+		log.Info("Inserting currencies...")
+		for _, currency := range currencies {
+			if tx := db.Create(&currency); tx.Error != nil {
+				return tx.Error
+			}
+		}
+	}
+
+	if currencyNameCount == 0 && len(currencyNames) > 0 {
+		log.Info("Inserting currency names...")
+		if tx := db.CreateInBatches(&currencyNames, 1000); tx.Error != nil {
+			return tx.Error
+		}
+	}
+
+	return nil
+}
+
+// newNumberFormats creates the number formats of a locale for every numbering system with symbols.
+// Patterns missing for a numbering system fall back to the patterns of the default numbering system.
+func newNumberFormats(locale string, numbers map[string]interface{}) []models.NumberFormat {
+	if numbers == nil {
+		return nil
+	}
+
+	defaultSystem := jsonString(numbers, "defaultNumberingSystem")
+	minimumGroupingDigits, err := strconv.Atoi(jsonString(numbers, "minimumGroupingDigits"))
+	if err != nil {
+		minimumGroupingDigits = 1
+	}
+
+	pattern := func(system, formats, key string) string {
+		for _, s := range []string{system, defaultSystem, "latn"} {
+			if value := jsonString(jsonObject(numbers, formats+"-numberSystem-"+s), key); value != "" {
+				return value
+			}
+		}
+		return ""
+	}
+
+	numberFormats := make([]models.NumberFormat, 0)
+	for key := range numbers {
+		system, ok := strings.CutPrefix(key, "symbols-numberSystem-")
+		if !ok {
+			continue
+		}
+		symbols := jsonObject(numbers, key)
+
+		numberFormat := models.NumberFormat{
+			LocaleID:              locale,
+			NumberingSystemID:     system,
+			Default:               system == defaultSystem,
+			MinimumGroupingDigits: minimumGroupingDigits,
+			Decimal:               jsonString(symbols, "decimal"),
+			Group:                 jsonString(symbols, "group"),
+			PercentSign:           jsonString(symbols, "percentSign"),
+			PlusSign:              jsonString(symbols, "plusSign"),
+			MinusSign:             jsonString(symbols, "minusSign"),
+			PerMille:              jsonString(symbols, "perMille"),
+			Exponential:           jsonString(symbols, "exponential"),
+			Infinity:              jsonString(symbols, "infinity"),
+			NaN:                   jsonString(symbols, "nan"),
+			DecimalPattern:        pattern(system, "decimalFormats", "standard"),
+			PercentPattern:        pattern(system, "percentFormats", "standard"),
+			ScientificPattern:     pattern(system, "scientificFormats", "standard"),
+			CurrencyPattern:       pattern(system, "currencyFormats", "standard"),
+			AccountingPattern:     pattern(system, "currencyFormats", "accounting"),
+		}
+		if numberFormat.Decimal == "" || numberFormat.DecimalPattern == "" {
+			continue
+		}
+
+		numberFormats = append(numberFormats, numberFormat)
+	}
+
+	return numberFormats
+}
@@ -0,0 +1,74 @@
+package database
+
+import (
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+	"database/sql"
+	"strings"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+// seedCLDRPluralRules seeds the cardinal and ordinal plural rules of CLDR supplemental/plurals.json and ordinals.json.
+func seedCLDRPluralRules(db *gorm.DB) error {
+	var pluralRuleCount int64
+	_ = db.Model(&models.PluralRule{}).Count(&pluralRuleCount)
+	if pluralRuleCount > 0 {
+		return nil // Data already seeded; skip.
+	}
+
+	pluralRules := make([]models.PluralRule, 0)
+	files := map[enums.PluralType]string{
+		enums.CARDINAL: "plurals",
+		enums.ORDINAL:  "ordinals",
+	}
+
+	for pluralType, file := range files {
+		doc, err := readJSONFile(cldrBasePath + "cldr-core/supplemental/" + file + ".json")
+		if err != nil {
+			return err
+		}
+
+		rulesByLocale := jsonObject(doc, "supplemental", "plurals-type-"+pluralType.String())
+		for locale := range rulesByLocale {
+			for key, rule := range jsonStrings(jsonObject(rulesByLocale, locale)) {
+				if !strings.HasPrefix(key, "pluralRule-count-") {
+					continue
+				}
+
+				pluralRules = append(pluralRules, newPluralRule(locale, pluralType, strings.TrimPrefix(key, "pluralRule-count-"), rule))
+			}
+		}
+	}
+
+	if len(pluralRules) > 0 {
+		log.Info("Inserting plural rules...")
+		if tx := db.CreateInBatches(&pluralRules, 500); tx.Error != nil {
+			return tx.Error
+		}
+	}
+
+	return nil
+}
+
+// newPluralRule splits a CLDR plural rule like "i = 1 and v = 0 @integer 1 @decimal 1.0" into its condition and samples.
+func newPluralRule(locale string, pluralType enums.PluralType, category, rule string) models.PluralRule {
+	pluralRule := models.PluralRule{LocaleID: locale, Type: pluralType, Category: category}
+
+	condition := rule
+	if i := strings.Index(rule, "@"); i >= 0 {
+		condition = rule[:i]
+		for _, samples := range strings.Split(rule[i+1:], "@") {
+			switch {
+			case strings.HasPrefix(samples, "integer"):
+				pluralRule.IntegerSamples = sql.NullString{String: strings.TrimSpace(strings.TrimPrefix(samples, "integer")), Valid: true}
+			case strings.HasPrefix(samples, "decimal"):
+				pluralRule.DecimalSamples = sql.NullString{String: strings.TrimSpace(strings.TrimPrefix(samples, "decimal")), Valid: true}
+			}
+		}
+	}
+	pluralRule.Rule = strings.TrimSpace(condition)
+
+	return pluralRule
+}
@@ -0,0 +1,91 @@
+package database
+
+import (
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+	"strings"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+// seedCLDRRelativeTimes seeds the relative time data of the date fields of cldr-dates-full.
+func seedCLDRRelativeTimes(db *gorm.DB) error {
+	var relativeTimeFormatCount int64
+	_ = db.Model(&models.RelativeTimeFormat{}).Count(&relativeTimeFormatCount)
+	if relativeTimeFormatCount > 0 {
+		return nil // Data already seeded; skip.
+	}
+
+	localeIDs, err := seededLocaleIDs(db)
+	if err != nil {
+		return err
+	}
+
+	relativeTimeFormats := make([]models.RelativeTimeFormat, 0)
+	for _, locale := range localeIDs {
+		fieldsDoc, err := readJSONFile(cldrBasePath + "cldr-dates-full/main/" + locale + "/dateFields.json")
+		if err != nil {
+			continue
+		}
+
+		fields := jsonObject(fieldsDoc, "main", locale, "dates", "fields")
+		for key := range fields {
+			field := jsonObject(fields, key)
+			future := relativeTimePatterns(jsonObject(field, "relativeTime-type-future"))
+			past := relativeTimePatterns(jsonObject(field, "relativeTime-type-past"))
+			if len(future) == 0 && len(past) == 0 {
+				continue // Fields like era or zone have no relative time.
+			}
+
+			// The key is the unit with an optional width suffix, e.g. day, day-short, day-narrow.
+			unit, width := key, enums.WIDTH_LONG
+			if before, suffix, found := strings.Cut(key, "-"); found {
+				width = ""
+				width.Convert(suffix)
+				if width == "" {
+					continue
+				}
+				unit = before
+			}
+
+			relative := make(map[string]string)
+			for name, value := range jsonStrings(field) {
+				if offset, found := strings.CutPrefix(name, "relative-type-"); found {
+					relative[offset] = value
+				}
+			}
+
+			relativeTimeFormats = append(relativeTimeFormats, models.RelativeTimeFormat{
+				LocaleID:    locale,
+				Unit:        unit,
+				Width:       width,
+				DisplayName: jsonString(field, "displayName"),
+				Relative:    relative,
+				Future:      future,
+				Past:        past,
+			})
+		}
+	}
+
+	if len(relativeTimeFormats) > 0 {
+		log.Info("Inserting relative time formats...")
+		if tx := db.CreateInBatches(&relativeTimeFormats, 500); tx.Error != nil {
+			return tx.Error
+		}
+	}
+
+	return nil
+}
+
+// relativeTimePatterns converts the relativeTimePattern-count-<category> patterns to patterns by plural category.
+func relativeTimePatterns(object map[string]interface{}) map[string]string {
+	patterns := make(map[string]string)
+	for key, value := range jsonStrings(object) {
+		if category, found := strings.CutPrefix(key, "relativeTimePattern-count-"); found {
+			patterns[category] = value
+		}
+	}
+
+	return patterns
+}
@@ -0,0 +1,80 @@
+package database
+
+import (
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+// seedCLDRScripts seeds the writing direction of the scripts of cldr-core script metadata
+// and the languages that use them of the supplemental language data.
+func seedCLDRScripts(db *gorm.DB) error {
+	var scriptLanguageCount int64
+	_ = db.Model(&models.ScriptLanguage{}).Count(&scriptLanguageCount)
+	if scriptLanguageCount > 0 {
+		return nil // Data already seeded; skip.
+	}
+
+	scriptMetadataDoc, err := readJSONFile(cldrBasePath + "cldr-core/scriptMetadata.json")
+	if err != nil {
+		return err
+	}
+	languageDataDoc, err := readJSONFile(cldrBasePath + "cldr-core/supplemental/languageData.json")
+	if err != nil {
+		return err
+	}
+
+	scriptIDs := make([]string, 0)
+	if tx := db.Model(&models.Script{}).Pluck("id", &scriptIDs); tx.Error != nil {
+		return tx.Error
+	}
+
+	rtlScriptIDs := make([]string, 0)
+	for scriptID := range jsonObject(scriptMetadataDoc, "scriptMetadata") {
+		if jsonString(jsonObject(scriptMetadataDoc, "scriptMetadata", scriptID), "rtl") == "YES" {
+			rtlScriptIDs = append(rtlScriptIDs, scriptID)
+		}
+	}
+
+	// Languages are listed with their main scripts, e.g. "en", and secondary scripts, e.g. "en-alt-secondary".
+	scriptLanguages := make([]models.ScriptLanguage, 0)
+	for key := range jsonObject(languageDataDoc, "supplemental", "languageData") {
+		languageID, secondary := strings.CutSuffix(key, "-alt-secondary")
+		scripts, _ := jsonObject(languageDataDoc, "supplemental", "languageData", key)["_scripts"].([]interface{})
+		for _, script := range scripts {
+			scriptID, ok := script.(string)
+			if !ok || !slices.Contains(scriptIDs, scriptID) {
+				continue
+			}
+			scriptLanguage := models.ScriptLanguage{ScriptID: scriptID, LanguageID: languageID, Secondary: secondary}
+			if i := slices.IndexFunc(scriptLanguages, func(sl models.ScriptLanguage) bool {
+				return sl.ScriptID == scriptID && sl.LanguageID == languageID
+			}); i >= 0 {
+				scriptLanguages[i].Secondary = scriptLanguages[i].Secondary && secondary
+				continue
+			}
+			scriptLanguages = append(scriptLanguages, scriptLanguage)
+		}
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if len(rtlScriptIDs) > 0 {
+			log.Info("Updating script directions...")
+			if result := tx.Model(&models.Script{}).Where("id IN ?", rtlScriptIDs).Update("direction", enums.RTL); result.Error != nil {
+				return result.Error
+			}
+		}
+		if len(scriptLanguages) > 0 {
+			log.Info("Inserting script languages...")
+			if result := tx.CreateInBatches(&scriptLanguages, 1000); result.Error != nil {
+				return result.Error
+			}
+		}
+
+		return nil
+	})
+}
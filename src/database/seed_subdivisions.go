@@ -0,0 +1,75 @@
+package database
+
+import (
+	"api-i18n/main/src/models"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+// seedCLDRSubdivisions seeds the subdivisions and their localized names of cldr-localenames-full.
+// CLDR subdivision codes like usca are stored as their ISO 3166-2 code, e.g. US-CA.
+func seedCLDRSubdivisions(db *gorm.DB) error {
+	var subdivisionCount int64
+	_ = db.Model(&models.Subdivision{}).Count(&subdivisionCount)
+	if subdivisionCount > 0 {
+		return nil // Data already seeded; skip.
+	}
+
+	localeIDs, err := seededLocaleIDs(db)
+	if err != nil {
+		return err
+	}
+
+	territoryIDs := make([]string, 0)
+	if tx := db.Model(&models.Territory{}).Pluck("id", &territoryIDs); tx.Error != nil {
+		return tx.Error
+	}
+
+	subdivisions := make([]models.Subdivision, 0)
+	subdivisionIDs := make(map[string]bool)
+	subdivisionNames := make([]models.SubdivisionName, 0)
+	for _, locale := range localeIDs {
+		subdivisionsDoc, err := readJSONFile(cldrBasePath + "cldr-localenames-full/main/" + locale + "/subdivisions.json")
+		if err != nil {
+			continue // Most locales have no subdivision names.
+		}
+
+		for code, name := range jsonStrings(jsonObject(subdivisionsDoc, "main", locale, "localeDisplayNames", "subdivisions", "subdivision")) {
+			if len(code) < 3 || strings.Contains(code, "-alt-") {
+				continue
+			}
+
+			territoryID := strings.ToUpper(code[:2])
+			if !slices.Contains(territoryIDs, territoryID) {
+				continue
+			}
+
+			id := territoryID + "-" + strings.ToUpper(code[2:])
+			if !subdivisionIDs[id] {
+				subdivisionIDs[id] = true
+				subdivisions = append(subdivisions, models.Subdivision{ID: id, TerritoryID: territoryID})
+			}
+			subdivisionNames = append(subdivisionNames, models.SubdivisionName{SubdivisionID: id, LocaleID: locale, Name: name})
+		}
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if len(subdivisions) > 0 {
+			log.Info("Inserting subdivisions...")
+			if result := tx.CreateInBatches(&subdivisions, 500); result.Error != nil {
+				return result.Error
+			}
+		}
+		if len(subdivisionNames) > 0 {
+			log.Info("Inserting subdivision names...")
+			if result := tx.CreateInBatches(&subdivisionNames, 500); result.Error != nil {
+				return result.Error
+			}
+		}
+
+		return nil
+	})
+}
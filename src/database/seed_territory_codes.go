@@ -0,0 +1,60 @@
+package database
+
+import (
+	"api-i18n/main/src/models"
+	"database/sql"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+// seedCLDRTerritoryCodes seeds the alpha-3 and numeric territory codes of the cldr-core code mappings.
+// UN M.49 regions like 419 get their own ID as numeric code.
+func seedCLDRTerritoryCodes(db *gorm.DB) error {
+	var territoryCodeCount int64
+	_ = db.Model(&models.TerritoryCode{}).Count(&territoryCodeCount)
+	if territoryCodeCount > 0 {
+		return nil // Data already seeded; skip.
+	}
+
+	codeMappingsDoc, err := readJSONFile(cldrBasePath + "cldr-core/supplemental/codeMappings.json")
+	if err != nil {
+		return err
+	}
+
+	territoryIDs := make([]string, 0)
+	if tx := db.Model(&models.Territory{}).Pluck("id", &territoryIDs); tx.Error != nil {
+		return tx.Error
+	}
+
+	codeMappings := jsonObject(codeMappingsDoc, "supplemental", "codeMappings")
+	territoryCodes := make([]models.TerritoryCode, 0, len(territoryIDs))
+	for _, territoryID := range territoryIDs {
+		territoryCode := models.TerritoryCode{TerritoryID: territoryID}
+
+		if isNumeric(territoryID) {
+			territoryCode.Numeric = sql.NullString{String: territoryID, Valid: true}
+		} else if mapping := jsonObject(codeMappings, territoryID); mapping != nil {
+			if alpha3 := jsonString(mapping, "_alpha3"); alpha3 != "" {
+				territoryCode.Alpha3 = sql.NullString{String: alpha3, Valid: true}
+			}
+			if numeric := jsonString(mapping, "_numeric"); numeric != "" {
+				territoryCode.Numeric = sql.NullString{String: numeric, Valid: true}
+			}
+		}
+
+		if !territoryCode.Alpha3.Valid && !territoryCode.Numeric.Valid {
+			continue
+		}
+		territoryCodes = append(territoryCodes, territoryCode)
+	}
+
+	if len(territoryCodes) > 0 {
+		log.Info("Inserting territory codes...")
+		if tx := db.CreateInBatches(&territoryCodes, 500); tx.Error != nil {
+			return tx.Error
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package database
+
+import (
+	"api-i18n/main/src/models"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+// seedCLDRTerritoryContainment seeds the territory containment of cldr-core.
+// Alternative and deprecated groupings (e.g. 151-status-grouping) and territories without names are skipped.
+func seedCLDRTerritoryContainment(db *gorm.DB) error {
+	var containmentCount int64
+	_ = db.Model(&models.TerritoryContainment{}).Count(&containmentCount)
+	if containmentCount > 0 {
+		return nil // Data already seeded; skip.
+	}
+
+	containmentDoc, err := readJSONFile(cldrBasePath + "cldr-core/supplemental/territoryContainment.json")
+	if err != nil {
+		return err
+	}
+
+	territoryIDs := make([]string, 0)
+	if tx := db.Model(&models.Territory{}).Pluck("id", &territoryIDs); tx.Error != nil {
+		return tx.Error
+	}
+
+	// Territories are seeded with their names; unknown IDs would otherwise need a type, which can't be told from the ID.
+	containments := make([]models.TerritoryContainment, 0)
+	for parentID := range jsonObject(containmentDoc, "supplemental", "territoryContainment") {
+		if strings.Contains(parentID, "-status-") || !slices.Contains(territoryIDs, parentID) {
+			continue
+		}
+
+		group := jsonObject(containmentDoc, "supplemental", "territoryContainment", parentID)
+		children, _ := group["_contains"].([]interface{})
+		grouping := jsonString(group, "_grouping") == "true" || group["_grouping"] == true
+
+		for _, child := range children {
+			childID, ok := child.(string)
+			if !ok || !slices.Contains(territoryIDs, childID) {
+				continue
+			}
+			containments = append(containments, models.TerritoryContainment{ParentID: parentID, ChildID: childID, Grouping: grouping})
+		}
+	}
+
+	if len(containments) > 0 {
+		log.Info("Inserting territory containments...")
+		if result := db.CreateInBatches(&containments, 500); result.Error != nil {
+			return result.Error
+		}
+	}
+
+	return nil
+}
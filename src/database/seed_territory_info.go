@@ -0,0 +1,174 @@
+package database
+
+import (
+	"api-i18n/main/src/models"
+	"database/sql"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+// seedCLDRTerritoryInfo seeds the population, languages, currencies, week data and measurement data
+// of the territories from cldr-core.
+func seedCLDRTerritoryInfo(db *gorm.DB) error {
+	var territoryInfoCount int64
+	_ = db.Model(&models.TerritoryInfo{}).Count(&territoryInfoCount)
+	if territoryInfoCount > 0 {
+		return nil // Data already seeded; skip.
+	}
+
+	territoryInfoDoc, err := readJSONFile(cldrBasePath + "cldr-core/supplemental/territoryInfo.json")
+	if err != nil {
+		return err
+	}
+	currencyDataDoc, err := readJSONFile(cldrBasePath + "cldr-core/supplemental/currencyData.json")
+	if err != nil {
+		return err
+	}
+	weekDataDoc, err := readJSONFile(cldrBasePath + "cldr-core/supplemental/weekData.json")
+	if err != nil {
+		return err
+	}
+	measurementDataDoc, err := readJSONFile(cldrBasePath + "cldr-core/supplemental/measurementData.json")
+	if err != nil {
+		return err
+	}
+
+	territoryIDs := make([]string, 0)
+	if tx := db.Model(&models.Territory{}).Pluck("id", &territoryIDs); tx.Error != nil {
+		return tx.Error
+	}
+	currencyIDs := make([]string, 0)
+	if tx := db.Model(&models.Currency{}).Pluck("id", &currencyIDs); tx.Error != nil {
+		return tx.Error
+	}
+
+	// territoryValue returns the value of a territory in a supplemental map, or the world (001) default.
+	territoryValue := func(values map[string]interface{}, territoryID string) string {
+		if value := jsonString(values, territoryID); value != "" {
+			return value
+		}
+		return jsonString(values, "001")
+	}
+
+	weekData := jsonObject(weekDataDoc, "supplemental", "weekData")
+	measurementData := jsonObject(measurementDataDoc, "supplemental", "measurementData")
+
+	territoryInfos := make([]models.TerritoryInfo, 0, len(territoryIDs))
+	territoryLanguages := make([]models.TerritoryLanguage, 0)
+	for _, territoryID := range territoryIDs {
+		territoryInfo := models.TerritoryInfo{
+			TerritoryID:       territoryID,
+			FirstDay:          territoryValue(jsonObject(weekData, "firstDay"), territoryID),
+			WeekendStart:      territoryValue(jsonObject(weekData, "weekendStart"), territoryID),
+			WeekendEnd:        territoryValue(jsonObject(weekData, "weekendEnd"), territoryID),
+			MeasurementSystem: territoryValue(jsonObject(measurementData, "measurementSystem"), territoryID),
+			PaperSize:         territoryValue(jsonObject(measurementData, "paperSize"), territoryID),
+		}
+		if minDays, err := strconv.Atoi(territoryValue(jsonObject(weekData, "minDays"), territoryID)); err == nil {
+			territoryInfo.MinDays = minDays
+		}
+
+		info := jsonObject(territoryInfoDoc, "supplemental", "territoryInfo", territoryID)
+		if population, err := strconv.ParseInt(jsonString(info, "_population"), 10, 64); err == nil {
+			territoryInfo.Population = sql.Null[int64]{V: population, Valid: true}
+		}
+		territoryInfos = append(territoryInfos, territoryInfo)
+
+		for languageID := range jsonObject(info, "languagePopulation") {
+			language := jsonObject(info, "languagePopulation", languageID)
+			percent, err := strconv.ParseFloat(jsonString(language, "_populationPercent"), 64)
+			if err != nil {
+				continue
+			}
+			officialStatus := jsonString(language, "_officialStatus")
+			territoryLanguages = append(territoryLanguages, models.TerritoryLanguage{
+				TerritoryID:       territoryID,
+				LanguageID:        strings.ReplaceAll(languageID, "_", "-"),
+				PopulationPercent: percent,
+				OfficialStatus:    sql.NullString{String: officialStatus, Valid: officialStatus != ""},
+			})
+		}
+	}
+
+	// The currencies of a region are listed as single-key objects, e.g. [{"USD": {"_from": "1792-01-01"}}].
+	territoryCurrencies := make([]models.TerritoryCurrency, 0)
+	currencies := make([]models.Currency, 0)
+	for territoryID, value := range jsonObject(currencyDataDoc, "supplemental", "currencyData", "region") {
+		entries, ok := value.([]interface{})
+		if !ok || !slices.Contains(territoryIDs, territoryID) {
+			continue
+		}
+		seen := make(map[string]bool)
+		for priority, entry := range entries {
+			entryMap, ok := entry.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for currencyID := range entryMap {
+				// A currency can be listed for several periods; the first listing is the most recent.
+				if seen[currencyID] {
+					continue
+				}
+				seen[currencyID] = true
+
+				currency := jsonObject(entryMap, currencyID)
+				if !slices.Contains(currencyIDs, currencyID) {
+					currencyIDs = append(currencyIDs, currencyID)
+					currencies = append(currencies, models.Currency{ID: currencyID, Digits: 2})
+				}
+				territoryCurrencies = append(territoryCurrencies, models.TerritoryCurrency{
+					TerritoryID: territoryID,
+					CurrencyID:  currencyID,
+					From:        supplementalDate(jsonString(currency, "_from")),
+					To:          supplementalDate(jsonString(currency, "_to")),
+					Tender:      jsonString(currency, "_tender") != "false",
+					Priority:    priority,
+				})
+			}
+		}
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if len(territoryInfos) > 0 {
+			log.Info("Inserting territory info...")
+			if result := tx.CreateInBatches(&territoryInfos, 500); result.Error != nil {
+				return result.Error
+			}
+		}
+		if len(territoryLanguages) > 0 {
+			log.Info("Inserting territory languages...")
+			if result := tx.CreateInBatches(&territoryLanguages, 1000); result.Error != nil {
+				return result.Error
+			}
+		}
+		if len(currencies) > 0 {
+			log.Info("Inserting historic currencies...")
+			if result := tx.CreateInBatches(&currencies, 500); result.Error != nil {
+				return result.Error
+			}
+		}
+		if len(territoryCurrencies) > 0 {
+			log.Info("Inserting territory currencies...")
+			if result := tx.CreateInBatches(&territoryCurrencies, 1000); result.Error != nil {
+				return result.Error
+			}
+		}
+
+		return nil
+	})
+}
+
+// supplementalDate parses a date of the supplemental data, e.g. 1792-01-01, as a nullable time.
+func supplementalDate(value string) sql.NullTime {
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{Time: date, Valid: true}
+}
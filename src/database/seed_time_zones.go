@@ -0,0 +1,252 @@
+package database
+
+import (
+	"api-i18n/main/src/models"
+	"database/sql"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+// seedCLDRTimeZones seeds the time zones of cldr-bcp47 with their territory and current metazone of cldr-core,
+// and the localized time zone and metazone names of cldr-dates-full.
+func seedCLDRTimeZones(db *gorm.DB) error {
+	var timeZoneCount int64
+	_ = db.Model(&models.TimeZone{}).Count(&timeZoneCount)
+	if timeZoneCount > 0 {
+		return nil // Data already seeded; skip.
+	}
+
+	bcp47Doc, err := readJSONFile(cldrBasePath + "cldr-bcp47/bcp47/timezone.json")
+	if err != nil {
+		return err
+	}
+	metaZonesDoc, err := readJSONFile(cldrBasePath + "cldr-core/supplemental/metaZones.json")
+	if err != nil {
+		return err
+	}
+	primaryZonesDoc, err := readJSONFile(cldrBasePath + "cldr-core/supplemental/primaryZones.json")
+	if err != nil {
+		return err
+	}
+	windowsZonesDoc, err := readJSONFile(cldrBasePath + "cldr-core/supplemental/windowsZones.json")
+	if err != nil {
+		return err
+	}
+
+	territoryIDs := make([]string, 0)
+	if tx := db.Model(&models.Territory{}).Pluck("id", &territoryIDs); tx.Error != nil {
+		return tx.Error
+	}
+
+	// The current metazone of a time zone is the one without an end date.
+	currentMetazones := make(map[string]string)
+	var walkMetazones func(node map[string]interface{}, path []string)
+	walkMetazones = func(node map[string]interface{}, path []string) {
+		for key, value := range node {
+			switch v := value.(type) {
+			case map[string]interface{}:
+				walkMetazones(v, append(slices.Clone(path), key))
+			case []interface{}:
+				for _, period := range v {
+					periodObject, ok := period.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					uses := jsonObject(periodObject, "usesMetazone")
+					if _, ended := uses["_to"]; uses != nil && !ended {
+						currentMetazones[strings.Join(append(slices.Clone(path), key), "/")] = jsonString(uses, "_mzone")
+					}
+				}
+			}
+		}
+	}
+	walkMetazones(jsonObject(metaZonesDoc, "supplemental", "metaZones", "metazoneInfo", "timezone"), nil)
+
+	// The territory of a time zone is its primary zone, or the territory of its metazone or Windows zone mapping.
+	// Mappings to 001 (the golden zone of a metazone) and ZZ don't belong to a territory.
+	zoneTerritories := make(map[string]string)
+	for territoryID, zoneID := range jsonStrings(jsonObject(primaryZonesDoc, "supplemental", "primaryZones")) {
+		if slices.Contains(territoryIDs, territoryID) {
+			zoneTerritories[zoneID] = territoryID
+		}
+	}
+	metazoneMapZones, _ := jsonObject(metaZonesDoc, "supplemental", "metaZones")["metazones"].([]interface{})
+	windowsMapZones, _ := jsonObject(windowsZonesDoc, "supplemental", "windowsZones")["mapTimezones"].([]interface{})
+	for _, mapZones := range [][]interface{}{metazoneMapZones, windowsMapZones} {
+		for _, value := range mapZones {
+			mapZone, ok := value.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			mapZone = jsonObject(mapZone, "mapZone")
+			territoryID := jsonString(mapZone, "_territory")
+			if territoryID == "001" || territoryID == "ZZ" || !slices.Contains(territoryIDs, territoryID) {
+				continue
+			}
+			for _, zoneID := range strings.Fields(jsonString(mapZone, "_type")) {
+				if _, ok := zoneTerritories[zoneID]; !ok {
+					zoneTerritories[zoneID] = territoryID
+				}
+			}
+		}
+	}
+
+	// The first alias of a BCP 47 time zone key is the CLDR canonical ID, e.g. Asia/Calcutta, which is used by
+	// the other CLDR data; the time zones are stored with their current IANA ID, e.g. Asia/Kolkata.
+	timeZones := make([]models.TimeZone, 0)
+	cldrZoneIDs := make(map[string]string)
+	metazones := make([]models.Metazone, 0)
+	zonesPerTerritory := make(map[string]int)
+	for key, value := range jsonObject(bcp47Doc, "keyword", "u", "tz") {
+		zone, ok := value.(map[string]interface{})
+		if !ok || strings.HasPrefix(key, "_") || jsonString(zone, "_deprecated") == "true" {
+			continue
+		}
+		aliases := strings.Fields(jsonString(zone, "_alias"))
+		if len(aliases) == 0 || strings.HasPrefix(aliases[0], "Etc/") {
+			continue
+		}
+
+		timeZone := models.TimeZone{ID: aliases[0]}
+		if ianaID := jsonString(zone, "_iana"); ianaID != "" {
+			timeZone.ID = ianaID
+		}
+		cldrZoneIDs[timeZone.ID] = aliases[0]
+
+		for _, alias := range aliases {
+			if territoryID, ok := zoneTerritories[alias]; ok {
+				timeZone.TerritoryID = sql.NullString{String: territoryID, Valid: true}
+				zonesPerTerritory[territoryID]++
+				break
+			}
+		}
+		if metazoneID, ok := currentMetazones[aliases[0]]; ok && metazoneID != "" {
+			timeZone.MetazoneID = sql.NullString{String: metazoneID, Valid: true}
+			if !slices.Contains(metazones, models.Metazone{ID: metazoneID}) {
+				metazones = append(metazones, models.Metazone{ID: metazoneID})
+			}
+		}
+		timeZones = append(timeZones, timeZone)
+	}
+
+	localeIDs, err := seededLocaleIDs(db)
+	if err != nil {
+		return err
+	}
+
+	metazoneNames := make([]models.MetazoneName, 0)
+	timeZoneNames := make([]models.TimeZoneName, 0)
+	for _, locale := range localeIDs {
+		namesDoc, err := readJSONFile(cldrBasePath + "cldr-dates-full/main/" + locale + "/timeZoneNames.json")
+		if err != nil {
+			continue
+		}
+		names := jsonObject(namesDoc, "main", locale, "dates", "timeZoneNames")
+		if names == nil {
+			continue
+		}
+
+		localeMetazoneNames := make(map[string]models.MetazoneName)
+		for metazoneID := range jsonObject(names, "metazone") {
+			if !slices.Contains(metazones, models.Metazone{ID: metazoneID}) {
+				continue
+			}
+			long := jsonObject(names, "metazone", metazoneID, "long")
+			short := jsonObject(names, "metazone", metazoneID, "short")
+			metazoneName := models.MetazoneName{
+				MetazoneID:    metazoneID,
+				LocaleID:      locale,
+				GenericLong:   timeZoneNullString(long, "generic"),
+				StandardLong:  timeZoneNullString(long, "standard"),
+				DaylightLong:  timeZoneNullString(long, "daylight"),
+				GenericShort:  timeZoneNullString(short, "generic"),
+				StandardShort: timeZoneNullString(short, "standard"),
+				DaylightShort: timeZoneNullString(short, "daylight"),
+			}
+			localeMetazoneNames[metazoneID] = metazoneName
+			metazoneNames = append(metazoneNames, metazoneName)
+		}
+
+		territoryNames := make([]models.TerritoryName, 0)
+		if tx := db.Find(&territoryNames, "locale_id = ?", locale); tx.Error != nil {
+			return tx.Error
+		}
+
+		regionFormat := jsonString(names, "regionFormat")
+		if regionFormat == "" {
+			regionFormat = "{0}"
+		}
+
+		for _, timeZone := range timeZones {
+			zone := jsonObject(names, append([]string{"zone"}, strings.Split(cldrZoneIDs[timeZone.ID], "/")...)...)
+
+			exemplarCity := jsonString(zone, "exemplarCity")
+			if exemplarCity == "" {
+				exemplarCity = strings.ReplaceAll(timeZone.ID[strings.LastIndex(timeZone.ID, "/")+1:], "_", " ")
+			}
+
+			// The generic name is the name of the zone, its metazone, or the region format with the
+			// territory name when the territory has one time zone and the exemplar city otherwise.
+			genericName := jsonString(jsonObject(zone, "long"), "generic")
+			if genericName == "" && timeZone.MetazoneID.Valid {
+				genericName = localeMetazoneNames[timeZone.MetazoneID.String].GenericLong.String
+			}
+			if genericName == "" {
+				location := exemplarCity
+				if timeZone.TerritoryID.Valid && zonesPerTerritory[timeZone.TerritoryID.String] == 1 {
+					for i := range territoryNames {
+						if territoryNames[i].TerritoryID == timeZone.TerritoryID.String {
+							location = territoryNames[i].Name
+						}
+					}
+				}
+				genericName = strings.ReplaceAll(regionFormat, "{0}", location)
+			}
+
+			timeZoneNames = append(timeZoneNames, models.TimeZoneName{
+				TimeZoneID:   timeZone.ID,
+				LocaleID:     locale,
+				ExemplarCity: exemplarCity,
+				GenericName:  genericName,
+			})
+		}
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if len(metazones) > 0 {
+			log.Info("Inserting metazones...")
+			if result := tx.CreateInBatches(&metazones, 500); result.Error != nil {
+				return result.Error
+			}
+		}
+		if len(timeZones) > 0 {
+			log.Info("Inserting time zones...")
+			if result := tx.CreateInBatches(&timeZones, 500); result.Error != nil {
+				return result.Error
+			}
+		}
+		if len(metazoneNames) > 0 {
+			log.Info("Inserting metazone names...")
+			if result := tx.CreateInBatches(&metazoneNames, 1000); result.Error != nil {
+				return result.Error
+			}
+		}
+		if len(timeZoneNames) > 0 {
+			log.Info("Inserting time zone names...")
+			if result := tx.CreateInBatches(&timeZoneNames, 1000); result.Error != nil {
+				return result.Error
+			}
+		}
+
+		return nil
+	})
+}
+
+// timeZoneNullString returns the string value of a key in a JSON object as a nullable string.
+func timeZoneNullString(object map[string]interface{}, key string) sql.NullString {
+	value := jsonString(object, key)
+	return sql.NullString{String: value, Valid: value != ""}
+}
@@ -0,0 +1,11 @@
+package requests
+
+type CreateGlossaryTerm struct {
+	AppName        string                    `json:"appName" validate:"required"`
+	LocaleID       string                    `json:"localeId" validate:"required"`
+	Term           string                    `json:"term" validate:"required"`
+	Description    *string                   `json:"description"`
+	DoNotTranslate bool                      `json:"doNotTranslate"`
+	CaseSensitive  bool                      `json:"caseSensitive"`
+	Translations   []GlossaryTermTranslation `json:"translations" validate:"dive"`
+}
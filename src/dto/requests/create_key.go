@@ -3,10 +3,14 @@ package requests
 import "time"
 
 type CreateKey struct {
-	CategoryID   *uint                  `json:"categoryId"`
-	AppName      string                 `json:"appName" validate:"required"`
-	Name         string                 `json:"name" validate:"required"`
-	Description  *string                `json:"description"`
-	DisabledAt   *time.Time             `json:"disabledAt"`
-	Translations []CreateKeyTranslation `json:"translations" validate:"required,min=1,dive"`
+	CategoryID    *uint                  `json:"categoryId"`
+	AppName       string                 `json:"appName" validate:"required"`
+	Name          string                 `json:"name" validate:"required"`
+	Description   *string                `json:"description"`
+	DisabledAt    *time.Time             `json:"disabledAt"`
+	MaxCharacters *int                   `json:"maxCharacters" validate:"omitempty,min=1"`
+	MaxGraphemes  *int                   `json:"maxGraphemes" validate:"omitempty,min=1"`
+	MaxLines      *int                   `json:"maxLines" validate:"omitempty,min=1"`
+	LengthLimits  []KeyLengthLimit       `json:"lengthLimits" validate:"dive"`
+	Translations  []CreateKeyTranslation `json:"translations" validate:"required,min=1,dive"`
 }
@@ -0,0 +1,8 @@
+package requests
+
+type CreateKeyComment struct {
+	LocaleID *string  `json:"localeId"`
+	ParentID *uint    `json:"parentId"`
+	Body     string   `json:"body" validate:"required"`
+	Mentions []string `json:"mentions" validate:"dive,required"`
+}
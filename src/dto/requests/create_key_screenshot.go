@@ -0,0 +1,20 @@
+package requests
+
+// CreateKeyScreenshot request DTO for the form fields of a screenshot upload.
+// The region fields are optional, but must be set all together.
+type CreateKeyScreenshot struct {
+	RegionX      *int `form:"regionX" validate:"omitempty,min=0"`
+	RegionY      *int `form:"regionY" validate:"omitempty,min=0"`
+	RegionWidth  *int `form:"regionWidth" validate:"omitempty,min=1"`
+	RegionHeight *int `form:"regionHeight" validate:"omitempty,min=1"`
+}
+
+// HasRegion returns true when all region fields are set.
+func (cks *CreateKeyScreenshot) HasRegion() bool {
+	return cks.RegionX != nil && cks.RegionY != nil && cks.RegionWidth != nil && cks.RegionHeight != nil
+}
+
+// HasPartialRegion returns true when some, but not all, region fields are set.
+func (cks *CreateKeyScreenshot) HasPartialRegion() bool {
+	return !cks.HasRegion() && (cks.RegionX != nil || cks.RegionY != nil || cks.RegionWidth != nil || cks.RegionHeight != nil)
+}
@@ -0,0 +1,8 @@
+package requests
+
+type CreateWebhook struct {
+	AppName string   `json:"appName" validate:"required"`
+	URL     string   `json:"url" validate:"required,url"`
+	Secret  *string  `json:"secret"`
+	Events  []string `json:"events" validate:"required,min=1,dive,required"`
+}
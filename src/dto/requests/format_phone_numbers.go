@@ -0,0 +1,12 @@
+package requests
+
+type FormatPhoneNumbers struct {
+	TerritoryID *string       `json:"territoryId"`
+	LocaleID    *string       `json:"localeId"`
+	Numbers     []PhoneNumber `json:"numbers" validate:"required,min=1,max=50000,dive"`
+}
+
+type PhoneNumber struct {
+	PhoneNumber string  `json:"phoneNumber" validate:"required"`
+	TerritoryID *string `json:"territoryId"`
+}
@@ -0,0 +1,6 @@
+package requests
+
+type GlossaryTermTranslation struct {
+	LocaleID string `json:"localeId" validate:"required"`
+	Value    string `json:"value" validate:"required"`
+}
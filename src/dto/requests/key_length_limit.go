@@ -0,0 +1,8 @@
+package requests
+
+type KeyLengthLimit struct {
+	LocaleID      string `json:"localeId" validate:"required"`
+	MaxCharacters *int   `json:"maxCharacters" validate:"omitempty,min=1"`
+	MaxGraphemes  *int   `json:"maxGraphemes" validate:"omitempty,min=1"`
+	MaxLines      *int   `json:"maxLines" validate:"omitempty,min=1"`
+}
@@ -0,0 +1,13 @@
+package requests
+
+import "time"
+
+type UpdateGlossaryTerm struct {
+	LocaleID       string                    `json:"localeId" validate:"required"`
+	Term           string                    `json:"term" validate:"required"`
+	Description    *string                   `json:"description"`
+	DoNotTranslate bool                      `json:"doNotTranslate"`
+	CaseSensitive  bool                      `json:"caseSensitive"`
+	UpdatedAt      time.Time                 `json:"updatedAt" validate:"required"`
+	Translations   []GlossaryTermTranslation `json:"translations" validate:"dive"`
+}
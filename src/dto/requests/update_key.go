@@ -3,10 +3,14 @@ package requests
 import "time"
 
 type UpdateKey struct {
-	CategoryID   *uint                  `json:"categoryId"`
-	Name         string                 `json:"name" validate:"required"`
-	Description  *string                `json:"description"`
-	DisabledAt   *time.Time             `json:"disabledAt"`
-	UpdatedAt    time.Time              `json:"updatedAt" validate:"required"`
-	Translations []UpdateKeyTranslation `json:"translations" validate:"required,dive"`
+	CategoryID    *uint                  `json:"categoryId"`
+	Name          string                 `json:"name" validate:"required"`
+	Description   *string                `json:"description"`
+	DisabledAt    *time.Time             `json:"disabledAt"`
+	MaxCharacters *int                   `json:"maxCharacters" validate:"omitempty,min=1"`
+	MaxGraphemes  *int                   `json:"maxGraphemes" validate:"omitempty,min=1"`
+	MaxLines      *int                   `json:"maxLines" validate:"omitempty,min=1"`
+	LengthLimits  []KeyLengthLimit       `json:"lengthLimits" validate:"dive"`
+	UpdatedAt     time.Time              `json:"updatedAt" validate:"required"`
+	Translations  []UpdateKeyTranslation `json:"translations" validate:"required,dive"`
 }
@@ -4,8 +4,9 @@ import "api-i18n/main/src/models"
 
 // AppLocale represents the response structure for application locale settings.
 type AppLocale struct {
-	AppName string   `json:"appName"`
-	Locales []string `json:"locales"`
+	AppName        string   `json:"appName"`
+	SourceLocaleID *string  `json:"sourceLocaleId"`
+	Locales        []string `json:"locales"`
 }
 
 // SetAppLocale sets the application name and locales in the response.
@@ -23,3 +24,8 @@ func (al *AppLocale) SetAppLocaleSimple(appName string, locales []string) {
 	al.AppName = appName
 	al.Locales = locales
 }
+
+// SetSourceLocale sets the source locale of the application in the response.
+func (al *AppLocale) SetSourceLocale(sourceLocaleID *string) {
+	al.SourceLocaleID = sourceLocaleID
+}
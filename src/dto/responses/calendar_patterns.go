@@ -0,0 +1,36 @@
+package responses
+
+import "api-i18n/main/src/models"
+
+type CalendarPatterns struct {
+	LocaleID         string                       `json:"localeId"`
+	DateFormats      map[string]string            `json:"dateFormats"`
+	TimeFormats      map[string]string            `json:"timeFormats"`
+	DateTimeFormats  map[string]string            `json:"dateTimeFormats"`
+	AvailableFormats map[string]string            `json:"availableFormats"`
+	Months           models.CalendarNames         `json:"months"`
+	Days             models.CalendarNames         `json:"days"`
+	Quarters         models.CalendarNames         `json:"quarters"`
+	DayPeriods       models.CalendarNames         `json:"dayPeriods"`
+	Eras             map[string]map[string]string `json:"eras"`
+	GMTFormat        string                       `json:"gmtFormat"`
+	GMTZeroFormat    string                       `json:"gmtZeroFormat"`
+	HourFormat       string                       `json:"hourFormat"`
+}
+
+// SetCalendarPatterns sets the patterns, names and localized GMT format of a calendar format.
+func (cp *CalendarPatterns) SetCalendarPatterns(calendarFormat *models.CalendarFormat) {
+	cp.LocaleID = calendarFormat.LocaleID
+	cp.DateFormats = calendarFormat.DateFormats
+	cp.TimeFormats = calendarFormat.TimeFormats
+	cp.DateTimeFormats = calendarFormat.DateTimeFormats
+	cp.AvailableFormats = calendarFormat.AvailableFormats
+	cp.Months = calendarFormat.Months
+	cp.Days = calendarFormat.Days
+	cp.Quarters = calendarFormat.Quarters
+	cp.DayPeriods = calendarFormat.DayPeriods
+	cp.Eras = calendarFormat.Eras
+	cp.GMTFormat = calendarFormat.GMTFormat
+	cp.GMTZeroFormat = calendarFormat.GMTZeroFormat
+	cp.HourFormat = calendarFormat.HourFormat
+}
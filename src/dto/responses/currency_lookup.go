@@ -0,0 +1,20 @@
+package responses
+
+import "api-i18n/main/src/models"
+
+type CurrencyLookup struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Symbol       string `json:"symbol"`
+	NarrowSymbol string `json:"narrowSymbol"`
+	Digits       int    `json:"digits"`
+}
+
+// SetCurrencyLookup sets the currency lookup fields from a CurrencyName model.
+func (cl *CurrencyLookup) SetCurrencyLookup(cn *models.CurrencyName) {
+	cl.ID = cn.CurrencyID
+	cl.Name = cn.Name
+	cl.Symbol = cn.Symbol
+	cl.NarrowSymbol = cn.NarrowSymbol
+	cl.Digits = cn.Currency.Digits
+}
@@ -0,0 +1,17 @@
+package responses
+
+import "api-i18n/main/src/models"
+
+type CurrencyLookupList struct {
+	Currencies []CurrencyLookup `json:"currencies"`
+}
+
+// SetCurrencyLookupList sets the list of currency lookups.
+func (cll *CurrencyLookupList) SetCurrencyLookupList(currencies *[]models.CurrencyName) {
+	cll.Currencies = make([]CurrencyLookup, len(*currencies))
+	for i, currency := range *currencies {
+		var cl CurrencyLookup
+		cl.SetCurrencyLookup(&currency)
+		cll.Currencies[i] = cl
+	}
+}
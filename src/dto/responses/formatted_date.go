@@ -0,0 +1,18 @@
+package responses
+
+type FormattedDate struct {
+	LocaleID  string `json:"localeId"`
+	TimeZone  string `json:"timeZone"`
+	Pattern   string `json:"pattern"`
+	Timestamp string `json:"timestamp"`
+	Formatted string `json:"formatted"`
+}
+
+// SetFormattedDate sets the fields of a formatted date.
+func (fd *FormattedDate) SetFormattedDate(localeID, timeZone, pattern, timestamp, formatted string) {
+	fd.LocaleID = localeID
+	fd.TimeZone = timeZone
+	fd.Pattern = pattern
+	fd.Timestamp = timestamp
+	fd.Formatted = formatted
+}
@@ -0,0 +1,18 @@
+package responses
+
+type FormattedList struct {
+	LocaleID  string   `json:"localeId"`
+	Type      string   `json:"type"`
+	Style     string   `json:"style"`
+	Items     []string `json:"items"`
+	Formatted string   `json:"formatted"`
+}
+
+// SetFormattedList sets the fields of a formatted list.
+func (fl *FormattedList) SetFormattedList(localeID, listType, style string, items []string, formatted string) {
+	fl.LocaleID = localeID
+	fl.Type = listType
+	fl.Style = style
+	fl.Items = items
+	fl.Formatted = formatted
+}
@@ -0,0 +1,20 @@
+package responses
+
+type FormattedNumber struct {
+	LocaleID        string  `json:"localeId"`
+	NumberingSystem string  `json:"numberingSystem"`
+	Style           string  `json:"style"`
+	Currency        *string `json:"currency"`
+	Number          string  `json:"number"`
+	Formatted       string  `json:"formatted"`
+}
+
+// SetFormattedNumber sets the fields of a formatted or parsed number.
+func (fn *FormattedNumber) SetFormattedNumber(localeID, numberingSystem, style string, currency *string, number, formatted string) {
+	fn.LocaleID = localeID
+	fn.NumberingSystem = numberingSystem
+	fn.Style = style
+	fn.Currency = currency
+	fn.Number = number
+	fn.Formatted = formatted
+}
@@ -0,0 +1,20 @@
+package responses
+
+type FormattedRelativeTime struct {
+	LocaleID    string `json:"localeId"`
+	Unit        string `json:"unit"`
+	DisplayName string `json:"displayName"`
+	Style       string `json:"style"`
+	Value       string `json:"value"`
+	Formatted   string `json:"formatted"`
+}
+
+// SetFormattedRelativeTime sets the fields of a formatted relative time.
+func (frt *FormattedRelativeTime) SetFormattedRelativeTime(localeID, unit, displayName, style, value, formatted string) {
+	frt.LocaleID = localeID
+	frt.Unit = unit
+	frt.DisplayName = displayName
+	frt.Style = style
+	frt.Value = value
+	frt.Formatted = formatted
+}
@@ -0,0 +1,15 @@
+package responses
+
+// GlossaryCompliance is the glossary compliance report of an app.
+type GlossaryCompliance struct {
+	AppName     string              `json:"appName"`
+	CheckedKeys int                 `json:"checkedKeys"`
+	Violations  []GlossaryViolation `json:"violations"`
+}
+
+// SetGlossaryCompliance sets the glossary compliance report fields.
+func (gc *GlossaryCompliance) SetGlossaryCompliance(appName string, checkedKeys int, violations []GlossaryViolation) {
+	gc.AppName = appName
+	gc.CheckedKeys = checkedKeys
+	gc.Violations = violations
+}
@@ -0,0 +1,8 @@
+package responses
+
+// GlossaryImport is the result of a TBX glossary import.
+type GlossaryImport struct {
+	Created int `json:"created"`
+	Updated int `json:"updated"`
+	Skipped int `json:"skipped"`
+}
@@ -0,0 +1,42 @@
+package responses
+
+import (
+	"api-i18n/main/src/models"
+	"time"
+)
+
+type GlossaryTerm struct {
+	ID             uint                      `json:"id"`
+	AppName        string                    `json:"appName"`
+	LocaleID       string                    `json:"localeId"`
+	Term           string                    `json:"term"`
+	Description    *string                   `json:"description"`
+	DoNotTranslate bool                      `json:"doNotTranslate"`
+	CaseSensitive  bool                      `json:"caseSensitive"`
+	CreatedAt      time.Time                 `json:"createdAt"`
+	UpdatedAt      time.Time                 `json:"updatedAt"`
+	Translations   []GlossaryTermTranslation `json:"translations"`
+}
+
+// SetGlossaryTerm func to set glossary term response from glossary term model.
+func (gt *GlossaryTerm) SetGlossaryTerm(term *models.GlossaryTerm) {
+	gt.ID = term.ID
+	gt.AppName = term.AppName
+	gt.LocaleID = term.LocaleID
+	gt.Term = term.Term
+
+	if term.Description.Valid {
+		gt.Description = &term.Description.String
+	}
+
+	gt.DoNotTranslate = term.DoNotTranslate
+	gt.CaseSensitive = term.CaseSensitive
+	gt.CreatedAt = term.CreatedAt
+	gt.UpdatedAt = term.UpdatedAt
+
+	gt.Translations = make([]GlossaryTermTranslation, len(term.Translations))
+	for i, translation := range term.Translations {
+		gt.Translations[i] = GlossaryTermTranslation{}
+		gt.Translations[i].SetGlossaryTermTranslation(&translation)
+	}
+}
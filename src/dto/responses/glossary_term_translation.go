@@ -0,0 +1,21 @@
+package responses
+
+import (
+	"api-i18n/main/src/models"
+	"time"
+)
+
+type GlossaryTermTranslation struct {
+	LocaleID  string    `json:"localeId"`
+	Value     string    `json:"value"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// SetGlossaryTermTranslation func to set glossary term translation response from glossary term translation model.
+func (gtt *GlossaryTermTranslation) SetGlossaryTermTranslation(translation *models.GlossaryTermTranslation) {
+	gtt.LocaleID = translation.LocaleID
+	gtt.Value = translation.Value
+	gtt.CreatedAt = translation.CreatedAt
+	gtt.UpdatedAt = translation.UpdatedAt
+}
@@ -0,0 +1,12 @@
+package responses
+
+// GlossaryViolation describes a translation that does not follow a glossary term.
+type GlossaryViolation struct {
+	KeyID          uint   `json:"keyId"`
+	KeyName        string `json:"keyName"`
+	LocaleID       string `json:"localeId"`
+	GlossaryTermID uint   `json:"glossaryTermId"`
+	Term           string `json:"term"`
+	Expected       string `json:"expected"`
+	Type           string `json:"type"`
+}
@@ -6,16 +6,22 @@ import (
 )
 
 type Key struct {
-	ID           uint             `json:"id"`
-	AppName      string           `json:"appName"`
-	CategoryID   *uint            `json:"categoryId"`
-	Name         string           `json:"name"`
-	Description  *string          `json:"description"`
-	DisabledAt   *time.Time       `json:"disabledAt"`
-	CreatedAt    time.Time        `json:"createdAt"`
-	UpdatedAt    time.Time        `json:"updatedAt"`
-	Category     *Category        `json:"category"`
-	Translations []KeyTranslation `json:"translations"`
+	ID            uint                `json:"id"`
+	AppName       string              `json:"appName"`
+	CategoryID    *uint               `json:"categoryId"`
+	Name          string              `json:"name"`
+	Description   *string             `json:"description"`
+	DisabledAt    *time.Time          `json:"disabledAt"`
+	MaxCharacters *int                `json:"maxCharacters"`
+	MaxGraphemes  *int                `json:"maxGraphemes"`
+	MaxLines      *int                `json:"maxLines"`
+	LengthLimits  []KeyLengthLimit    `json:"lengthLimits"`
+	CreatedAt     time.Time           `json:"createdAt"`
+	UpdatedAt     time.Time           `json:"updatedAt"`
+	Category      *Category           `json:"category"`
+	Translations  []KeyTranslation    `json:"translations"`
+	Screenshots   []KeyScreenshot     `json:"screenshots"`
+	Warnings      []GlossaryViolation `json:"warnings,omitempty"`
 }
 
 // SetKey func to set key response from key model.
@@ -37,6 +43,22 @@ func (k *Key) SetKey(key *models.Key) {
 		k.DisabledAt = &key.DisabledAt.Time
 	}
 
+	if key.MaxCharacters.Valid {
+		k.MaxCharacters = &key.MaxCharacters.V
+	}
+	if key.MaxGraphemes.Valid {
+		k.MaxGraphemes = &key.MaxGraphemes.V
+	}
+	if key.MaxLines.Valid {
+		k.MaxLines = &key.MaxLines.V
+	}
+
+	k.LengthLimits = make([]KeyLengthLimit, len(key.LengthLimits))
+	for i, limit := range key.LengthLimits {
+		k.LengthLimits[i] = KeyLengthLimit{}
+		k.LengthLimits[i].SetKeyLengthLimit(&limit)
+	}
+
 	k.CreatedAt = key.CreatedAt
 	k.UpdatedAt = key.UpdatedAt
 
@@ -52,4 +74,10 @@ func (k *Key) SetKey(key *models.Key) {
 			k.Translations[i].SetKeyTranslation(&translation)
 		}
 	}
+
+	k.Screenshots = make([]KeyScreenshot, len(key.Screenshots))
+	for i, screenshot := range key.Screenshots {
+		k.Screenshots[i] = KeyScreenshot{}
+		k.Screenshots[i].SetKeyScreenshot(&screenshot)
+	}
 }
@@ -0,0 +1,60 @@
+package responses
+
+import (
+	"api-i18n/main/src/models"
+	"time"
+)
+
+type KeyComment struct {
+	ID         uint         `json:"id"`
+	KeyID      uint         `json:"keyId"`
+	LocaleID   *string      `json:"localeId"`
+	ParentID   *uint        `json:"parentId"`
+	Author     string       `json:"author"`
+	Body       string       `json:"body"`
+	Mentions   []string     `json:"mentions"`
+	ResolvedAt *time.Time   `json:"resolvedAt"`
+	ResolvedBy *string      `json:"resolvedBy"`
+	CreatedAt  time.Time    `json:"createdAt"`
+	UpdatedAt  time.Time    `json:"updatedAt"`
+	Replies    []KeyComment `json:"replies,omitempty"`
+}
+
+// SetKeyComment func to set key comment response from key comment model, including its replies.
+func (kc *KeyComment) SetKeyComment(comment *models.KeyComment) {
+	kc.ID = comment.ID
+	kc.KeyID = comment.KeyID
+
+	if comment.LocaleID.Valid {
+		kc.LocaleID = &comment.LocaleID.String
+	}
+	if comment.ParentID.Valid {
+		kc.ParentID = &comment.ParentID.V
+	}
+
+	kc.Author = comment.Author
+	kc.Body = comment.Body
+
+	kc.Mentions = make([]string, len(comment.Mentions))
+	for i := range comment.Mentions {
+		kc.Mentions[i] = comment.Mentions[i].Mention
+	}
+
+	if comment.ResolvedAt.Valid {
+		kc.ResolvedAt = &comment.ResolvedAt.Time
+	}
+	if comment.ResolvedBy.Valid {
+		kc.ResolvedBy = &comment.ResolvedBy.String
+	}
+
+	kc.CreatedAt = comment.CreatedAt
+	kc.UpdatedAt = comment.UpdatedAt
+
+	if len(comment.Replies) > 0 {
+		kc.Replies = make([]KeyComment, len(comment.Replies))
+		for i := range comment.Replies {
+			kc.Replies[i] = KeyComment{}
+			kc.Replies[i].SetKeyComment(&comment.Replies[i])
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package responses
+
+import "api-i18n/main/src/models"
+
+type KeyCommentList struct {
+	Comments []KeyComment `json:"comments"`
+}
+
+// SetKeyCommentList sets the list of key comment threads.
+func (kcl *KeyCommentList) SetKeyCommentList(comments *[]models.KeyComment) {
+	kcl.Comments = make([]KeyComment, len(*comments))
+	for i := range *comments {
+		var kc KeyComment
+		kc.SetKeyComment(&(*comments)[i])
+		kcl.Comments[i] = kc
+	}
+}
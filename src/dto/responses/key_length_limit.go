@@ -0,0 +1,25 @@
+package responses
+
+import "api-i18n/main/src/models"
+
+type KeyLengthLimit struct {
+	LocaleID      string `json:"localeId"`
+	MaxCharacters *int   `json:"maxCharacters"`
+	MaxGraphemes  *int   `json:"maxGraphemes"`
+	MaxLines      *int   `json:"maxLines"`
+}
+
+// SetKeyLengthLimit func to set key length limit response from key length limit model.
+func (kll *KeyLengthLimit) SetKeyLengthLimit(limit *models.KeyLengthLimit) {
+	kll.LocaleID = limit.LocaleID
+
+	if limit.MaxCharacters.Valid {
+		kll.MaxCharacters = &limit.MaxCharacters.V
+	}
+	if limit.MaxGraphemes.Valid {
+		kll.MaxGraphemes = &limit.MaxGraphemes.V
+	}
+	if limit.MaxLines.Valid {
+		kll.MaxLines = &limit.MaxLines.V
+	}
+}
@@ -0,0 +1,15 @@
+package responses
+
+// KeyLengthReport is the report of the translations of an app that exceed the length limits of their key.
+type KeyLengthReport struct {
+	AppName     string               `json:"appName"`
+	CheckedKeys int                  `json:"checkedKeys"`
+	Violations  []KeyLengthViolation `json:"violations"`
+}
+
+// SetKeyLengthReport sets the length limit report fields.
+func (klr *KeyLengthReport) SetKeyLengthReport(appName string, checkedKeys int, violations []KeyLengthViolation) {
+	klr.AppName = appName
+	klr.CheckedKeys = checkedKeys
+	klr.Violations = violations
+}
@@ -0,0 +1,11 @@
+package responses
+
+// KeyLengthViolation describes a translation that exceeds a length limit of its key.
+type KeyLengthViolation struct {
+	KeyID    uint   `json:"keyId"`
+	KeyName  string `json:"keyName"`
+	LocaleID string `json:"localeId"`
+	Type     string `json:"type"`
+	Max      int    `json:"max"`
+	Actual   int    `json:"actual"`
+}
@@ -0,0 +1,50 @@
+package responses
+
+import (
+	"api-i18n/main/src/models"
+	"api-i18n/main/src/storage"
+	"time"
+)
+
+type KeyScreenshot struct {
+	ID          uint                 `json:"id"`
+	KeyID       uint                 `json:"keyId"`
+	URL         string               `json:"url"`
+	FileName    string               `json:"fileName"`
+	ContentType string               `json:"contentType"`
+	Size        int64                `json:"size"`
+	Width       int                  `json:"width"`
+	Height      int                  `json:"height"`
+	Region      *KeyScreenshotRegion `json:"region"`
+	CreatedAt   time.Time            `json:"createdAt"`
+}
+
+type KeyScreenshotRegion struct {
+	X      int `json:"x"`
+	Y      int `json:"y"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// SetKeyScreenshot func to set key screenshot response from key screenshot model.
+func (ks *KeyScreenshot) SetKeyScreenshot(screenshot *models.KeyScreenshot) {
+	ks.ID = screenshot.ID
+	ks.KeyID = screenshot.KeyID
+	ks.URL = storage.URL(screenshot.Path)
+	ks.FileName = screenshot.FileName
+	ks.ContentType = screenshot.ContentType
+	ks.Size = screenshot.Size
+	ks.Width = screenshot.Width
+	ks.Height = screenshot.Height
+
+	if screenshot.RegionX.Valid && screenshot.RegionY.Valid && screenshot.RegionWidth.Valid && screenshot.RegionHeight.Valid {
+		ks.Region = &KeyScreenshotRegion{
+			X:      screenshot.RegionX.V,
+			Y:      screenshot.RegionY.V,
+			Width:  screenshot.RegionWidth.V,
+			Height: screenshot.RegionHeight.V,
+		}
+	}
+
+	ks.CreatedAt = screenshot.CreatedAt
+}
@@ -0,0 +1,17 @@
+package responses
+
+import "api-i18n/main/src/models"
+
+type KeyScreenshotList struct {
+	Screenshots []KeyScreenshot `json:"screenshots"`
+}
+
+// SetKeyScreenshotList sets the list of key screenshots.
+func (ksl *KeyScreenshotList) SetKeyScreenshotList(screenshots *[]models.KeyScreenshot) {
+	ksl.Screenshots = make([]KeyScreenshot, len(*screenshots))
+	for i, screenshot := range *screenshots {
+		var ks KeyScreenshot
+		ks.SetKeyScreenshot(&screenshot)
+		ksl.Screenshots[i] = ks
+	}
+}
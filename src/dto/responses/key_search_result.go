@@ -0,0 +1,47 @@
+package responses
+
+import "api-i18n/main/src/models"
+
+type KeySearchResult struct {
+	ID           uint             `json:"id"`
+	AppName      string           `json:"appName"`
+	CategoryID   *uint            `json:"categoryId"`
+	CategoryName *string          `json:"categoryName"`
+	Name         string           `json:"name"`
+	Description  *string          `json:"description"`
+	Rank         float64          `json:"rank"`
+	Matches      []KeySearchMatch `json:"matches"`
+}
+
+type KeySearchMatch struct {
+	Field    string  `json:"field"`
+	LocaleID *string `json:"localeId"`
+	Snippet  string  `json:"snippet"`
+}
+
+// SetKeySearchResult method to set key search result data from models.Key{}.
+func (ksr *KeySearchResult) SetKeySearchResult(key *models.Key, rank float64) {
+	ksr.ID = key.ID
+	ksr.AppName = key.AppName
+
+	if key.CategoryID.Valid {
+		ksr.CategoryID = &key.CategoryID.V
+	}
+	if key.Category != nil {
+		ksr.CategoryName = &key.Category.Name
+	}
+
+	ksr.Name = key.Name
+
+	if key.Description.Valid {
+		ksr.Description = &key.Description.String
+	}
+
+	ksr.Rank = rank
+	ksr.Matches = make([]KeySearchMatch, 0)
+}
+
+// AddMatch method to add a matched field with its highlighted snippet.
+func (ksr *KeySearchResult) AddMatch(field string, localeID *string, snippet string) {
+	ksr.Matches = append(ksr.Matches, KeySearchMatch{Field: field, LocaleID: localeID, Snippet: snippet})
+}
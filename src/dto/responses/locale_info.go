@@ -0,0 +1,45 @@
+package responses
+
+import "api-i18n/main/src/models"
+
+type LocaleInfo struct {
+	ID              string  `json:"id"`
+	LanguageID      string  `json:"languageId"`
+	ScriptID        *string `json:"scriptId"`
+	TerritoryID     *string `json:"territoryId"`
+	VariantID       *string `json:"variantId"`
+	Autonym         string  `json:"autonym"`
+	Name            *string `json:"name"`
+	Direction       string  `json:"direction"`
+	CoverageLevel   *string `json:"coverageLevel"`
+	NumberingSystem *string `json:"numberingSystem"`
+	ParentID        *string `json:"parentId"`
+}
+
+// SetLocaleInfo sets the locale info fields from a LocaleMetadata model, the autonym
+// and the name in the viewer locale, which is nil without a viewer locale.
+func (li *LocaleInfo) SetLocaleInfo(metadata *models.LocaleMetadata, autonym string, name *string) {
+	li.ID = metadata.LocaleID
+	li.LanguageID = metadata.Locale.LanguageID
+	if metadata.Locale.ScriptID.Valid {
+		li.ScriptID = &metadata.Locale.ScriptID.String
+	}
+	if metadata.Locale.TerritoryID.Valid {
+		li.TerritoryID = &metadata.Locale.TerritoryID.String
+	}
+	if metadata.Locale.VariantID.Valid {
+		li.VariantID = &metadata.Locale.VariantID.String
+	}
+	li.Autonym = autonym
+	li.Name = name
+	li.Direction = metadata.Direction.String()
+	if metadata.CoverageLevel.Valid {
+		li.CoverageLevel = &metadata.CoverageLevel.String
+	}
+	if metadata.NumberingSystemID.Valid {
+		li.NumberingSystem = &metadata.NumberingSystemID.String
+	}
+	if metadata.ParentLocaleID.Valid {
+		li.ParentID = &metadata.ParentLocaleID.String
+	}
+}
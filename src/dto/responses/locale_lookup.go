@@ -1,10 +1,18 @@
 package responses
 
-import "api-i18n/main/src/models"
+import (
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+)
 
 type LocaleLookup struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID              string  `json:"id"`
+	Name            string  `json:"name"`
+	Autonym         *string `json:"autonym,omitempty"`
+	Direction       *string `json:"direction,omitempty"`
+	CoverageLevel   *string `json:"coverageLevel,omitempty"`
+	NumberingSystem *string `json:"numberingSystem,omitempty"`
+	ParentID        *string `json:"parentId,omitempty"`
 }
 
 // SetLocaleLookup sets the locale lookup fields from a LocaleName model.
@@ -12,3 +20,23 @@ func (ll *LocaleLookup) SetLocaleLookup(ln *models.LocaleName) {
 	ll.ID = ln.LocaleIDTarget
 	ll.Name = ln.Name
 }
+
+// SetLocaleLookupDetails sets the autonym and the metadata fields of the locale lookup.
+func (ll *LocaleLookup) SetLocaleLookupDetails(autonym string, metadata *models.LocaleMetadata) {
+	ll.Autonym = &autonym
+
+	direction := enums.LTR.String()
+	if metadata.Direction != "" {
+		direction = metadata.Direction.String()
+	}
+	ll.Direction = &direction
+	if metadata.CoverageLevel.Valid {
+		ll.CoverageLevel = &metadata.CoverageLevel.String
+	}
+	if metadata.NumberingSystemID.Valid {
+		ll.NumberingSystem = &metadata.NumberingSystemID.String
+	}
+	if metadata.ParentLocaleID.Valid {
+		ll.ParentID = &metadata.ParentLocaleID.String
+	}
+}
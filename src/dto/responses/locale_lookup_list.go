@@ -15,3 +15,11 @@ func (lll *LocaleLookupList) SetLocaleLookupList(locales *[]models.LocaleName) {
 		lll.Locales[i] = ll
 	}
 }
+
+// SetLocaleLookupListDetails adds the autonyms and metadata, by locale ID, to the locale lookups.
+func (lll *LocaleLookupList) SetLocaleLookupListDetails(autonyms map[string]string, metadata map[string]models.LocaleMetadata) {
+	for i := range lll.Locales {
+		localeMetadata := metadata[lll.Locales[i].ID]
+		lll.Locales[i].SetLocaleLookupDetails(autonyms[lll.Locales[i].ID], &localeMetadata)
+	}
+}
@@ -0,0 +1,31 @@
+package responses
+
+import (
+	"api-i18n/main/src/models"
+	"time"
+)
+
+type PaginatedGlossaryTerm struct {
+	ID               uint      `json:"id"`
+	AppName          string    `json:"appName"`
+	LocaleID         string    `json:"localeId"`
+	Term             string    `json:"term"`
+	DoNotTranslate   bool      `json:"doNotTranslate"`
+	CaseSensitive    bool      `json:"caseSensitive"`
+	TranslationCount int       `json:"translationCount"`
+	CreatedAt        time.Time `json:"createdAt"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+}
+
+// SetPaginatedGlossaryTerm method to set glossary term data from models.GlossaryTerm{}.
+func (gt *PaginatedGlossaryTerm) SetPaginatedGlossaryTerm(term *models.GlossaryTerm) {
+	gt.ID = term.ID
+	gt.AppName = term.AppName
+	gt.LocaleID = term.LocaleID
+	gt.Term = term.Term
+	gt.DoNotTranslate = term.DoNotTranslate
+	gt.CaseSensitive = term.CaseSensitive
+	gt.TranslationCount = len(term.Translations)
+	gt.CreatedAt = term.CreatedAt
+	gt.UpdatedAt = term.UpdatedAt
+}
@@ -6,15 +6,19 @@ import (
 )
 
 type PaginatedKey struct {
-	ID                 uint       `json:"id"`
-	CategoryID         *uint      `json:"categoryId"`
-	Name               string     `json:"name"`
-	AppName            string     `json:"appName"`
-	DisabledAt         *time.Time `json:"disabledAt"`
-	CreatedAt          time.Time  `json:"createdAt"`
-	UpdatedAt          time.Time  `json:"updatedAt"`
-	CategoryName       *string    `json:"categoryName"`
-	CategoryDisabledAt *time.Time `json:"categoryDisabledAt"`
+	ID                 uint             `json:"id"`
+	CategoryID         *uint            `json:"categoryId"`
+	Name               string           `json:"name"`
+	AppName            string           `json:"appName"`
+	DisabledAt         *time.Time       `json:"disabledAt"`
+	MaxCharacters      *int             `json:"maxCharacters"`
+	MaxGraphemes       *int             `json:"maxGraphemes"`
+	MaxLines           *int             `json:"maxLines"`
+	LengthLimits       []KeyLengthLimit `json:"lengthLimits"`
+	CreatedAt          time.Time        `json:"createdAt"`
+	UpdatedAt          time.Time        `json:"updatedAt"`
+	CategoryName       *string          `json:"categoryName"`
+	CategoryDisabledAt *time.Time       `json:"categoryDisabledAt"`
 }
 
 // SetPaginatedKey method to set key data from models.Key{}.
@@ -37,6 +41,22 @@ func (k *PaginatedKey) SetPaginatedKey(key *models.Key) {
 		return nil
 	}()
 
+	if key.MaxCharacters.Valid {
+		k.MaxCharacters = &key.MaxCharacters.V
+	}
+	if key.MaxGraphemes.Valid {
+		k.MaxGraphemes = &key.MaxGraphemes.V
+	}
+	if key.MaxLines.Valid {
+		k.MaxLines = &key.MaxLines.V
+	}
+
+	k.LengthLimits = make([]KeyLengthLimit, len(key.LengthLimits))
+	for i, limit := range key.LengthLimits {
+		k.LengthLimits[i] = KeyLengthLimit{}
+		k.LengthLimits[i].SetKeyLengthLimit(&limit)
+	}
+
 	if key.Category != nil {
 		k.CategoryName = &key.Category.Name
 		k.CategoryDisabledAt = func() *time.Time {
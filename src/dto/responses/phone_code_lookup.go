@@ -1,9 +1,10 @@
 package responses
 
 type PhoneCodeLookup struct {
-	Code      string `json:"code"`
-	Territory string `json:"territory"`
-	Name      string `json:"name"`
+	Code      string         `json:"code"`
+	Territory string         `json:"territory"`
+	Name      string         `json:"name"`
+	Metadata  *PhoneMetadata `json:"metadata,omitempty"`
 }
 
 // SetPhoneCodeLookup sets the phone code lookup details.
@@ -0,0 +1,26 @@
+package responses
+
+type PhoneMetadata struct {
+	Region              string               `json:"region"`
+	Code                int                  `json:"code"`
+	NationalPrefix      *string              `json:"nationalPrefix"`
+	InternationalPrefix *string              `json:"internationalPrefix"`
+	PossibleLengths     []int                `json:"possibleLengths"`
+	Template            *PhoneNumberTemplate `json:"template"`
+	Examples            []PhoneNumberExample `json:"examples"`
+}
+
+type PhoneNumberExample struct {
+	Type            string              `json:"type"`
+	E164            string              `json:"e164"`
+	National        string              `json:"national"`
+	International   string              `json:"international"`
+	PossibleLengths []int               `json:"possibleLengths"`
+	Template        PhoneNumberTemplate `json:"template"`
+}
+
+// PhoneNumberTemplate is an input mask of a formatted number, in which X stands for a digit, e.g. "06 XXXXXXXX".
+type PhoneNumberTemplate struct {
+	National      string `json:"national"`
+	International string `json:"international"`
+}
@@ -0,0 +1,9 @@
+package responses
+
+type PhoneNumberAsYouType struct {
+	PhoneNumber string  `json:"phoneNumber"`
+	Formatted   string  `json:"formatted"`
+	Region      *string `json:"region"`
+	IsPossible  bool    `json:"isPossible"`
+	IsValid     bool    `json:"isValid"`
+}
@@ -0,0 +1,30 @@
+package responses
+
+type PhoneNumberBatch struct {
+	Numbers    []PhoneNumberBatchItem `json:"numbers"`
+	Valid      int                    `json:"valid"`
+	Invalid    int                    `json:"invalid"`
+	Duplicates int                    `json:"duplicates"`
+}
+
+type PhoneNumberBatchItem struct {
+	PhoneNumber string             `json:"phoneNumber"`
+	TerritoryID *string            `json:"territoryId"`
+	Format      *PhoneNumberFormat `json:"format"`
+	DedupKey    *string            `json:"dedupKey"`
+	Duplicate   bool               `json:"duplicate"`
+	Error       *string            `json:"error"`
+}
+
+// AddPhoneNumberBatchItem adds a formatted number to the batch and counts it.
+func (pnb *PhoneNumberBatch) AddPhoneNumberBatchItem(item PhoneNumberBatchItem) {
+	pnb.Numbers = append(pnb.Numbers, item)
+	if item.Format != nil && item.Format.IsValid {
+		pnb.Valid++
+	} else {
+		pnb.Invalid++
+	}
+	if item.Duplicate {
+		pnb.Duplicates++
+	}
+}
@@ -1,5 +1,6 @@
 package responses
 
 type PhoneNumberValid struct {
-	IsValid bool `json:"isValid"`
+	IsValid bool     `json:"isValid"`
+	Regions []string `json:"regions"`
 }
@@ -0,0 +1,18 @@
+package responses
+
+type PluralCategory struct {
+	LocaleID string         `json:"localeId"`
+	Number   string         `json:"number"`
+	Type     string         `json:"type"`
+	Category string         `json:"category"`
+	Operands PluralOperands `json:"operands"`
+}
+
+// SetPluralCategory sets the plural category fields of an evaluated number.
+func (pc *PluralCategory) SetPluralCategory(localeID, number, pluralType, category string, operands PluralOperands) {
+	pc.LocaleID = localeID
+	pc.Number = number
+	pc.Type = pluralType
+	pc.Category = category
+	pc.Operands = operands
+}
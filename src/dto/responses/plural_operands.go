@@ -0,0 +1,14 @@
+package responses
+
+// PluralOperands are the CLDR plural operands of a number.
+// See: https://unicode.org/reports/tr35/tr35-numbers.html#Plural_Operand_Meanings
+type PluralOperands struct {
+	N float64 `json:"n"`
+	I float64 `json:"i"`
+	V float64 `json:"v"`
+	W float64 `json:"w"`
+	F float64 `json:"f"`
+	T float64 `json:"t"`
+	C float64 `json:"c"`
+	E float64 `json:"e"`
+}
@@ -0,0 +1,23 @@
+package responses
+
+import "api-i18n/main/src/models"
+
+type PluralRule struct {
+	Category       string  `json:"category"`
+	Rule           string  `json:"rule"`
+	IntegerSamples *string `json:"integerSamples"`
+	DecimalSamples *string `json:"decimalSamples"`
+}
+
+// SetPluralRule sets the plural rule fields from a PluralRule model.
+func (pr *PluralRule) SetPluralRule(rule *models.PluralRule) {
+	pr.Category = rule.Category
+	pr.Rule = rule.Rule
+
+	if rule.IntegerSamples.Valid {
+		pr.IntegerSamples = &rule.IntegerSamples.String
+	}
+	if rule.DecimalSamples.Valid {
+		pr.DecimalSamples = &rule.DecimalSamples.String
+	}
+}
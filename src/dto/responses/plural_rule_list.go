@@ -0,0 +1,31 @@
+package responses
+
+import (
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+)
+
+type PluralRuleList struct {
+	LocaleID string       `json:"localeId"`
+	Cardinal []PluralRule `json:"cardinal"`
+	Ordinal  []PluralRule `json:"ordinal"`
+}
+
+// SetPluralRuleList sets the cardinal and ordinal plural rules of a locale.
+func (prl *PluralRuleList) SetPluralRuleList(localeID string, rules []models.PluralRule) {
+	prl.LocaleID = localeID
+	prl.Cardinal = make([]PluralRule, 0)
+	prl.Ordinal = make([]PluralRule, 0)
+
+	for i := range rules {
+		var pr PluralRule
+		pr.SetPluralRule(&rules[i])
+
+		switch rules[i].Type {
+		case enums.CARDINAL:
+			prl.Cardinal = append(prl.Cardinal, pr)
+		case enums.ORDINAL:
+			prl.Ordinal = append(prl.Ordinal, pr)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package responses
+
+import "api-i18n/main/src/models"
+
+type ScriptLookup struct {
+	ID                 string   `json:"id"`
+	Name               string   `json:"name"`
+	Direction          string   `json:"direction"`
+	Languages          []string `json:"languages"`
+	SecondaryLanguages []string `json:"secondaryLanguages"`
+}
+
+// SetScriptLookup sets the script lookup fields from a ScriptName model.
+func (sl *ScriptLookup) SetScriptLookup(sn *models.ScriptName) {
+	sl.ID = sn.ScriptID
+	sl.Name = sn.Name
+	sl.Direction = sn.Script.Direction.String()
+	sl.Languages = make([]string, 0)
+	sl.SecondaryLanguages = make([]string, 0)
+	for _, language := range sn.Languages {
+		if language.Secondary {
+			sl.SecondaryLanguages = append(sl.SecondaryLanguages, language.LanguageID)
+		} else {
+			sl.Languages = append(sl.Languages, language.LanguageID)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package responses
+
+import "api-i18n/main/src/models"
+
+type ScriptLookupList struct {
+	Scripts []ScriptLookup `json:"scripts"`
+}
+
+// SetScriptLookupList sets the list of script lookups.
+func (sll *ScriptLookupList) SetScriptLookupList(scripts *[]models.ScriptName) {
+	sll.Scripts = make([]ScriptLookup, len(*scripts))
+	for i, script := range *scripts {
+		var sl ScriptLookup
+		sl.SetScriptLookup(&script)
+		sll.Scripts[i] = sl
+	}
+}
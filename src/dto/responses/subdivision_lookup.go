@@ -0,0 +1,17 @@
+package responses
+
+import "api-i18n/main/src/models"
+
+type SubdivisionLookup struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	LocaleID string `json:"localeId"`
+}
+
+// SetSubdivisionLookup sets the subdivision lookup fields from a SubdivisionName model.
+// The locale is the one of the name, which may be a fallback of the requested locale.
+func (sl *SubdivisionLookup) SetSubdivisionLookup(sn *models.SubdivisionName) {
+	sl.ID = sn.SubdivisionID
+	sl.Name = sn.Name
+	sl.LocaleID = sn.LocaleID
+}
@@ -0,0 +1,17 @@
+package responses
+
+import "api-i18n/main/src/models"
+
+type SubdivisionLookupList struct {
+	Subdivisions []SubdivisionLookup `json:"subdivisions"`
+}
+
+// SetSubdivisionLookupList sets the list of subdivision lookups.
+func (sll *SubdivisionLookupList) SetSubdivisionLookupList(subdivisions *[]models.SubdivisionName) {
+	sll.Subdivisions = make([]SubdivisionLookup, len(*subdivisions))
+	for i, subdivision := range *subdivisions {
+		var sl SubdivisionLookup
+		sl.SetSubdivisionLookup(&subdivision)
+		sll.Subdivisions[i] = sl
+	}
+}
@@ -0,0 +1,38 @@
+package responses
+
+import (
+	"api-i18n/main/src/models"
+	"strings"
+)
+
+type TerritoryCodeConversion struct {
+	Code         string   `json:"code"`
+	ID           string   `json:"id"`
+	Type         string   `json:"type"`
+	Alpha3       *string  `json:"alpha3"`
+	Numeric      *string  `json:"numeric"`
+	Flag         *string  `json:"flag"`
+	Deprecated   bool     `json:"deprecated"`
+	Replacements []string `json:"replacements"`
+}
+
+// SetTerritoryCodeConversion sets the conversion fields of a code from a TerritoryCode model.
+// With an alias the code was deprecated or overlong, and all its replacement territories are listed.
+func (tcc *TerritoryCodeConversion) SetTerritoryCodeConversion(code string, tc *models.TerritoryCode, alias *models.LocaleAlias) {
+	tcc.Code = code
+	tcc.ID = tc.TerritoryID
+	tcc.Type = tc.Territory.Type.String()
+	if tc.Alpha3.Valid {
+		tcc.Alpha3 = &tc.Alpha3.String
+	}
+	if tc.Numeric.Valid {
+		tcc.Numeric = &tc.Numeric.String
+	}
+	tcc.Flag = territoryFlag(tc.TerritoryID)
+
+	tcc.Replacements = make([]string, 0)
+	if alias != nil {
+		tcc.Deprecated = alias.Reason == "deprecated"
+		tcc.Replacements = strings.Fields(alias.Replacement)
+	}
+}
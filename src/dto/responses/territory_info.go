@@ -0,0 +1,62 @@
+package responses
+
+import (
+	"api-i18n/main/src/models"
+	"time"
+)
+
+type TerritoryInfo struct {
+	ID                string              `json:"id"`
+	Type              string              `json:"type"`
+	Population        *int64              `json:"population"`
+	Languages         []TerritoryLanguage `json:"languages"`
+	Currencies        []TerritoryCurrency `json:"currencies"`
+	FirstDay          string              `json:"firstDay"`
+	MinDays           int                 `json:"minDays"`
+	WeekendStart      string              `json:"weekendStart"`
+	WeekendEnd        string              `json:"weekendEnd"`
+	MeasurementSystem string              `json:"measurementSystem"`
+	PaperSize         string              `json:"paperSize"`
+}
+
+type TerritoryLanguage struct {
+	ID                string  `json:"id"`
+	PopulationPercent float64 `json:"populationPercent"`
+	OfficialStatus    *string `json:"officialStatus"`
+}
+
+type TerritoryCurrency struct {
+	ID   string     `json:"id"`
+	From *time.Time `json:"from"`
+}
+
+// SetTerritoryInfo sets the territory info fields from a TerritoryInfo model.
+func (ti *TerritoryInfo) SetTerritoryInfo(info *models.TerritoryInfo) {
+	ti.ID = info.TerritoryID
+	ti.Type = info.Territory.Type.String()
+	if info.Population.Valid {
+		ti.Population = &info.Population.V
+	}
+	ti.FirstDay = info.FirstDay
+	ti.MinDays = info.MinDays
+	ti.WeekendStart = info.WeekendStart
+	ti.WeekendEnd = info.WeekendEnd
+	ti.MeasurementSystem = info.MeasurementSystem
+	ti.PaperSize = info.PaperSize
+
+	ti.Languages = make([]TerritoryLanguage, len(info.Languages))
+	for i, language := range info.Languages {
+		ti.Languages[i] = TerritoryLanguage{ID: language.LanguageID, PopulationPercent: language.PopulationPercent}
+		if language.OfficialStatus.Valid {
+			ti.Languages[i].OfficialStatus = &language.OfficialStatus.String
+		}
+	}
+
+	ti.Currencies = make([]TerritoryCurrency, len(info.Currencies))
+	for i, currency := range info.Currencies {
+		ti.Currencies[i] = TerritoryCurrency{ID: currency.CurrencyID}
+		if currency.From.Valid {
+			ti.Currencies[i].From = &currency.From.Time
+		}
+	}
+}
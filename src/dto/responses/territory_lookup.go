@@ -1,11 +1,17 @@
 package responses
 
-import "api-i18n/main/src/models"
+import (
+	"api-i18n/main/src/models"
+	"strings"
+)
 
 type TerritoryLookup struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-	Type string `json:"type"`
+	ID      string  `json:"id"`
+	Name    string  `json:"name"`
+	Type    string  `json:"type"`
+	Alpha3  *string `json:"alpha3"`
+	Numeric *string `json:"numeric"`
+	Flag    *string `json:"flag"`
 }
 
 // SetTerritoryLookup sets the territory lookup fields from a TerritoryName model.
@@ -13,4 +19,43 @@ func (tl *TerritoryLookup) SetTerritoryLookup(tn *models.TerritoryName) {
 	tl.ID = tn.TerritoryID
 	tl.Name = tn.Name
 	tl.Type = tn.Territory.Type.String()
+	if tn.Code != nil {
+		if tn.Code.Alpha3.Valid {
+			tl.Alpha3 = &tn.Code.Alpha3.String
+		}
+		if tn.Code.Numeric.Valid {
+			tl.Numeric = &tn.Code.Numeric.String
+		}
+	}
+	tl.Flag = territoryFlag(tn.TerritoryID)
+}
+
+// flagTerritories are the territories with a flag emoji, the regions of the RGI emoji flag sequences of Unicode:
+// the ISO 3166-1 countries, the exceptionally reserved codes like IC, and EU and UN. Other groupings like EZ or QO
+// and the private use codes have no flag.
+var flagTerritories = func() map[string]bool {
+	territories := make(map[string]bool)
+	for _, id := range strings.Fields(`
+		AC AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE BF BG BH BI BJ BL BM BN BO BQ BR BS BT BV BW BY BZ
+		CA CC CD CF CG CH CI CK CL CM CN CO CP CR CU CV CW CX CY CZ DE DG DJ DK DM DO DZ EA EC EE EG EH ER ES ET EU FI FJ
+		FK FM FO FR GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY HK HM HN HR HT HU IC ID IE IL IM IN IO IQ IR
+		IS IT JE JM JO JP KE KG KH KI KM KN KP KR KW KY KZ LA LB LC LI LK LR LS LT LU LV LY MA MC MD ME MF MG MH MK ML MM
+		MN MO MP MQ MR MS MT MU MV MW MX MY MZ NA NC NE NF NG NI NL NO NP NR NU NZ OM PA PE PF PG PH PK PL PM PN PR PS PT
+		PW PY QA RE RO RS RU RW SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS ST SV SX SY SZ TA TC TD TF TG TH TJ TK TL
+		TM TN TO TR TT TV TW TZ UA UG UM UN US UY UZ VA VC VE VG VI VN VU WF WS XK YE YT ZA ZM ZW`) {
+		territories[id] = true
+	}
+
+	return territories
+}()
+
+// territoryFlag returns the flag emoji of an alpha-2 territory code, written as two regional indicator symbols.
+// Territories without a flag emoji, see flagTerritories, have no flag.
+func territoryFlag(territoryID string) *string {
+	if !flagTerritories[territoryID] {
+		return nil
+	}
+
+	flag := string([]rune{rune(territoryID[0]-'A') + 0x1F1E6, rune(territoryID[1]-'A') + 0x1F1E6})
+	return &flag
 }
@@ -0,0 +1,38 @@
+package responses
+
+import "testing"
+
+func TestTerritoryFlag(t *testing.T) {
+	tests := []struct {
+		territoryID string
+		want        string
+	}{
+		{territoryID: "NL", want: "\U0001F1F3\U0001F1F1"},
+		{territoryID: "XK", want: "\U0001F1FD\U0001F1F0"},
+		{territoryID: "EU", want: "\U0001F1EA\U0001F1FA"},
+		{territoryID: "IC", want: "\U0001F1EE\U0001F1E8"},
+		{territoryID: "EZ"},
+		{territoryID: "QO"},
+		{territoryID: "ZZ"},
+		{territoryID: "XA"},
+		{territoryID: "AN"},
+		{territoryID: "150"},
+		{territoryID: "nl"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.territoryID, func(t *testing.T) {
+			got := ""
+			if flag := territoryFlag(test.territoryID); flag != nil {
+				got = *flag
+			}
+			if got != test.want {
+				t.Errorf("territoryFlag(%q) = %q, want %q", test.territoryID, got, test.want)
+			}
+		})
+	}
+
+	if len(flagTerritories) != 258 {
+		t.Errorf("flagTerritories has %d territories, want the 258 RGI flags", len(flagTerritories))
+	}
+}
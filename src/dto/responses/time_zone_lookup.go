@@ -0,0 +1,41 @@
+package responses
+
+import (
+	"api-i18n/main/src/models"
+	"fmt"
+	"time"
+)
+
+type TimeZoneLookup struct {
+	ID               string  `json:"id"`
+	TerritoryID      *string `json:"territoryId"`
+	MetazoneID       *string `json:"metazoneId"`
+	GenericName      string  `json:"genericName"`
+	ExemplarCity     string  `json:"exemplarCity"`
+	UTCOffset        string  `json:"utcOffset"`
+	UTCOffsetSeconds int     `json:"utcOffsetSeconds"`
+}
+
+// SetTimeZoneLookup sets the time zone lookup fields from a TimeZoneName model with the UTC offset at the given time.
+func (tzl *TimeZoneLookup) SetTimeZoneLookup(tzn *models.TimeZoneName, now time.Time) {
+	tzl.ID = tzn.TimeZoneID
+	if tzn.TimeZone.TerritoryID.Valid {
+		tzl.TerritoryID = &tzn.TimeZone.TerritoryID.String
+	}
+	if tzn.TimeZone.MetazoneID.Valid {
+		tzl.MetazoneID = &tzn.TimeZone.MetazoneID.String
+	}
+	tzl.GenericName = tzn.GenericName
+	tzl.ExemplarCity = tzn.ExemplarCity
+
+	offset := 0
+	if location, err := time.LoadLocation(tzn.TimeZoneID); err == nil {
+		_, offset = now.In(location).Zone()
+	}
+	sign, absolute := "+", offset
+	if offset < 0 {
+		sign, absolute = "-", -offset
+	}
+	tzl.UTCOffset = fmt.Sprintf("%s%02d:%02d", sign, absolute/3600, absolute/60%60)
+	tzl.UTCOffsetSeconds = offset
+}
@@ -0,0 +1,20 @@
+package responses
+
+import (
+	"api-i18n/main/src/models"
+	"time"
+)
+
+type TimeZoneLookupList struct {
+	TimeZones []TimeZoneLookup `json:"timeZones"`
+}
+
+// SetTimeZoneLookupList sets the list of time zone lookups with their UTC offset at the given time.
+func (tzll *TimeZoneLookupList) SetTimeZoneLookupList(timeZones *[]models.TimeZoneName, now time.Time) {
+	tzll.TimeZones = make([]TimeZoneLookup, len(*timeZones))
+	for i, timeZone := range *timeZones {
+		var tzl TimeZoneLookup
+		tzl.SetTimeZoneLookup(&timeZone, now)
+		tzll.TimeZones[i] = tzl
+	}
+}
@@ -0,0 +1,14 @@
+package responses
+
+import "api-i18n/main/src/models"
+
+type VariantLookup struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// SetVariantLookup sets the variant lookup fields from a VariantName model.
+func (vl *VariantLookup) SetVariantLookup(vn *models.VariantName) {
+	vl.ID = vn.VariantID
+	vl.Name = vn.Name
+}
@@ -0,0 +1,17 @@
+package responses
+
+import "api-i18n/main/src/models"
+
+type VariantLookupList struct {
+	Variants []VariantLookup `json:"variants"`
+}
+
+// SetVariantLookupList sets the list of variant lookups.
+func (vll *VariantLookupList) SetVariantLookupList(variants *[]models.VariantName) {
+	vll.Variants = make([]VariantLookup, len(*variants))
+	for i, variant := range *variants {
+		var vl VariantLookup
+		vl.SetVariantLookup(&variant)
+		vll.Variants[i] = vl
+	}
+}
@@ -0,0 +1,28 @@
+package responses
+
+import (
+	"api-i18n/main/src/models"
+	"strings"
+	"time"
+)
+
+type Webhook struct {
+	ID        uint      `json:"id"`
+	AppName   string    `json:"appName"`
+	URL       string    `json:"url"`
+	HasSecret bool      `json:"hasSecret"`
+	Events    []string  `json:"events"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// SetWebhook func to set webhook response from webhook model.
+func (w *Webhook) SetWebhook(webhook *models.Webhook) {
+	w.ID = webhook.ID
+	w.AppName = webhook.AppName
+	w.URL = webhook.URL
+	w.HasSecret = webhook.Secret.Valid && webhook.Secret.String != ""
+	w.Events = strings.Split(webhook.Events, ",")
+	w.CreatedAt = webhook.CreatedAt
+	w.UpdatedAt = webhook.UpdatedAt
+}
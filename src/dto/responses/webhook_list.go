@@ -0,0 +1,17 @@
+package responses
+
+import "api-i18n/main/src/models"
+
+type WebhookList struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// SetWebhookList sets the list of webhooks.
+func (wl *WebhookList) SetWebhookList(webhooks *[]models.Webhook) {
+	wl.Webhooks = make([]Webhook, len(*webhooks))
+	for i := range *webhooks {
+		var w Webhook
+		w.SetWebhook(&(*webhooks)[i])
+		wl.Webhooks[i] = w
+	}
+}
@@ -0,0 +1,38 @@
+package enums
+
+import "database/sql/driver"
+
+type AliasType string
+
+const (
+	ALIAS_LANGUAGE  AliasType = "language"
+	ALIAS_SCRIPT    AliasType = "script"
+	ALIAS_TERRITORY AliasType = "territory"
+	ALIAS_VARIANT   AliasType = "variant"
+)
+
+func (at *AliasType) Scan(value interface{}) error {
+	*at = AliasType(value.(string))
+	return nil
+}
+
+func (at AliasType) Value() (driver.Value, error) {
+	return string(at), nil
+}
+
+func (at AliasType) String() string {
+	return string(at)
+}
+
+func (at *AliasType) Convert(value string) {
+	switch value {
+	case "language":
+		*at = ALIAS_LANGUAGE
+	case "script":
+		*at = ALIAS_SCRIPT
+	case "territory":
+		*at = ALIAS_TERRITORY
+	case "variant":
+		*at = ALIAS_VARIANT
+	}
+}
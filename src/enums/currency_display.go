@@ -0,0 +1,24 @@
+package enums
+
+type CurrencyDisplay string
+
+const (
+	SYMBOL        CurrencyDisplay = "symbol"
+	NARROW_SYMBOL CurrencyDisplay = "narrowSymbol"
+	CODE          CurrencyDisplay = "code"
+)
+
+func (cd CurrencyDisplay) String() string {
+	return string(cd)
+}
+
+func (cd *CurrencyDisplay) Convert(value string) {
+	switch value {
+	case "symbol":
+		*cd = SYMBOL
+	case "narrowSymbol":
+		*cd = NARROW_SYMBOL
+	case "code":
+		*cd = CODE
+	}
+}
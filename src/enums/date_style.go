@@ -0,0 +1,27 @@
+package enums
+
+type DateStyle string
+
+const (
+	FULL   DateStyle = "full"
+	LONG   DateStyle = "long"
+	MEDIUM DateStyle = "medium"
+	SHORT  DateStyle = "short"
+)
+
+func (ds DateStyle) String() string {
+	return string(ds)
+}
+
+func (ds *DateStyle) Convert(value string) {
+	switch value {
+	case "full":
+		*ds = FULL
+	case "long":
+		*ds = LONG
+	case "medium":
+		*ds = MEDIUM
+	case "short":
+		*ds = SHORT
+	}
+}
@@ -0,0 +1,32 @@
+package enums
+
+import "database/sql/driver"
+
+type Direction string
+
+const (
+	LTR Direction = "ltr"
+	RTL Direction = "rtl"
+)
+
+func (d *Direction) Scan(value interface{}) error {
+	*d = Direction(value.(string))
+	return nil
+}
+
+func (d Direction) Value() (driver.Value, error) {
+	return string(d), nil
+}
+
+func (d Direction) String() string {
+	return string(d)
+}
+
+func (d *Direction) Convert(value string) {
+	switch value {
+	case "ltr":
+		*d = LTR
+	case "rtl":
+		*d = RTL
+	}
+}
@@ -0,0 +1,35 @@
+package enums
+
+import "database/sql/driver"
+
+type FormatWidth string
+
+const (
+	WIDTH_LONG   FormatWidth = "long"
+	WIDTH_SHORT  FormatWidth = "short"
+	WIDTH_NARROW FormatWidth = "narrow"
+)
+
+func (fw *FormatWidth) Scan(value interface{}) error {
+	*fw = FormatWidth(value.(string))
+	return nil
+}
+
+func (fw FormatWidth) Value() (driver.Value, error) {
+	return string(fw), nil
+}
+
+func (fw FormatWidth) String() string {
+	return string(fw)
+}
+
+func (fw *FormatWidth) Convert(value string) {
+	switch value {
+	case "long":
+		*fw = WIDTH_LONG
+	case "short":
+		*fw = WIDTH_SHORT
+	case "narrow":
+		*fw = WIDTH_NARROW
+	}
+}
@@ -0,0 +1,12 @@
+package enums
+
+type GlossaryViolationType string
+
+const (
+	DO_NOT_TRANSLATE     GlossaryViolationType = "doNotTranslate"
+	APPROVED_TRANSLATION GlossaryViolationType = "approvedTranslation"
+)
+
+func (gvt GlossaryViolationType) String() string {
+	return string(gvt)
+}
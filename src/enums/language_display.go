@@ -0,0 +1,21 @@
+package enums
+
+type LanguageDisplay string
+
+const (
+	DIALECT  LanguageDisplay = "dialect"
+	STANDARD LanguageDisplay = "standard"
+)
+
+func (ld LanguageDisplay) String() string {
+	return string(ld)
+}
+
+func (ld *LanguageDisplay) Convert(value string) {
+	switch value {
+	case "dialect":
+		*ld = DIALECT
+	case "standard":
+		*ld = STANDARD
+	}
+}
@@ -0,0 +1,13 @@
+package enums
+
+type LengthLimitType string
+
+const (
+	MAX_CHARACTERS LengthLimitType = "maxCharacters"
+	MAX_GRAPHEMES  LengthLimitType = "maxGraphemes"
+	MAX_LINES      LengthLimitType = "maxLines"
+)
+
+func (llt LengthLimitType) String() string {
+	return string(llt)
+}
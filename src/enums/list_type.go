@@ -0,0 +1,35 @@
+package enums
+
+import "database/sql/driver"
+
+type ListType string
+
+const (
+	LIST_AND  ListType = "and"
+	LIST_OR   ListType = "or"
+	LIST_UNIT ListType = "unit"
+)
+
+func (lt *ListType) Scan(value interface{}) error {
+	*lt = ListType(value.(string))
+	return nil
+}
+
+func (lt ListType) Value() (driver.Value, error) {
+	return string(lt), nil
+}
+
+func (lt ListType) String() string {
+	return string(lt)
+}
+
+func (lt *ListType) Convert(value string) {
+	switch value {
+	case "and":
+		*lt = LIST_AND
+	case "or":
+		*lt = LIST_OR
+	case "unit":
+		*lt = LIST_UNIT
+	}
+}
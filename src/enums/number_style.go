@@ -0,0 +1,32 @@
+package enums
+
+type NumberStyle string
+
+const (
+	DECIMAL    NumberStyle = "decimal"
+	PERCENT    NumberStyle = "percent"
+	CURRENCY   NumberStyle = "currency"
+	ACCOUNTING NumberStyle = "accounting"
+)
+
+func (ns NumberStyle) String() string {
+	return string(ns)
+}
+
+func (ns *NumberStyle) Convert(value string) {
+	switch value {
+	case "decimal":
+		*ns = DECIMAL
+	case "percent":
+		*ns = PERCENT
+	case "currency":
+		*ns = CURRENCY
+	case "accounting":
+		*ns = ACCOUNTING
+	}
+}
+
+// IsCurrency checks if the style formats currency amounts.
+func (ns NumberStyle) IsCurrency() bool {
+	return ns == CURRENCY || ns == ACCOUNTING
+}
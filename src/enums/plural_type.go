@@ -0,0 +1,32 @@
+package enums
+
+import "database/sql/driver"
+
+type PluralType string
+
+const (
+	CARDINAL PluralType = "cardinal"
+	ORDINAL  PluralType = "ordinal"
+)
+
+func (pt *PluralType) Scan(value interface{}) error {
+	*pt = PluralType(value.(string))
+	return nil
+}
+
+func (pt PluralType) Value() (driver.Value, error) {
+	return string(pt), nil
+}
+
+func (pt PluralType) String() string {
+	return string(pt)
+}
+
+func (pt *PluralType) Convert(value string) {
+	switch value {
+	case "cardinal":
+		*pt = CARDINAL
+	case "ordinal":
+		*pt = ORDINAL
+	}
+}
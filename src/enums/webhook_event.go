@@ -0,0 +1,17 @@
+package enums
+
+type WebhookEvent string
+
+const (
+	COMMENT_CREATED  WebhookEvent = "comment.created"
+	COMMENT_RESOLVED WebhookEvent = "comment.resolved"
+	COMMENT_REOPENED WebhookEvent = "comment.reopened"
+	COMMENT_DELETED  WebhookEvent = "comment.deleted"
+)
+
+// WebhookEvents are all events webhooks can subscribe to.
+var WebhookEvents = []WebhookEvent{COMMENT_CREATED, COMMENT_RESOLVED, COMMENT_REOPENED, COMMENT_DELETED}
+
+func (we WebhookEvent) String() string {
+	return string(we)
+}
@@ -2,14 +2,38 @@ package errors
 
 // Define error codes as constants.
 const (
-	AppNotFound         = "appNotFound"
-	CategoryExists      = "categoryExists"
-	CategoryAvailable   = "categoryAvailable"
-	CategoryIsKey       = "categoryIsKey"
-	KeyExists           = "keyExists"
-	KeyAvailable        = "keyAvailable"
-	KeyIsCategory       = "keyIsCategory"
-	InvalidTranslations = "invalidTranslations"
-	LocaleNotFound      = "localeNotFound"
+	AppNotFound                = "appNotFound"
+	CategoryExists             = "categoryExists"
+	CategoryAvailable          = "categoryAvailable"
+	CategoryIsKey              = "categoryIsKey"
+	KeyExists                  = "keyExists"
+	KeyAvailable               = "keyAvailable"
+	KeyIsCategory              = "keyIsCategory"
+	InvalidTranslations        = "invalidTranslations"
+	LocaleNotFound             = "localeNotFound"
+	GlossaryTermExists         = "glossaryTermExists"
+	GlossaryTermAvailable      = "glossaryTermAvailable"
+	InvalidTBX                 = "invalidTbx"
+	SourceLocaleNotFound       = "sourceLocaleNotFound"
+	ScreenshotExists           = "screenshotExists"
+	InvalidScreenshot          = "invalidScreenshot"
+	CommentExists              = "commentExists"
+	WebhookExists              = "webhookExists"
+	InvalidWebhookEvent        = "invalidWebhookEvent"
+	KeyLengthExceeded          = "keyLengthExceeded"
+	InvalidLengthLimits        = "invalidLengthLimits"
+	InvalidNumber              = "invalidNumber"
+	NumberFormatNotFound       = "numberFormatNotFound"
+	CurrencyNotFound           = "currencyNotFound"
+	InvalidTimestamp           = "invalidTimestamp"
+	InvalidTimeZone            = "invalidTimeZone"
+	CalendarFormatNotFound     = "calendarFormatNotFound"
+	DatePatternNotFound        = "datePatternNotFound"
+	RelativeTimeFormatNotFound = "relativeTimeFormatNotFound"
+	ListPatternNotFound        = "listPatternNotFound"
+	TerritoryNotFound          = "territoryNotFound"
+	InvalidPhoneNumberCSV      = "invalidPhoneNumberCsv"
+	PhoneRegionNotFound        = "phoneRegionNotFound"
+	PhoneCodeNotFound          = "phoneCodeNotFound"
 	// Add more error codes as needed.
 )
@@ -1,8 +1,12 @@
 package models
 
+import "database/sql"
+
 type App struct {
-	Name string `gorm:"primaryKey:true;autoIncrement:false"`
+	Name           string         `gorm:"primaryKey:true;autoIncrement:false"`
+	SourceLocaleID sql.NullString `gorm:"size:32"`
 
 	// Relationships.
-	Locales []Locale `gorm:"many2many:app_locales;foreignKey:Name;joinForeignKey:AppName;references:ID;joinReferences:LocaleId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	SourceLocale *Locale  `gorm:"foreignKey:SourceLocaleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	Locales      []Locale `gorm:"many2many:app_locales;foreignKey:Name;joinForeignKey:AppName;references:ID;joinReferences:LocaleId;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 }
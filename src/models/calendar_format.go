@@ -0,0 +1,35 @@
+package models
+
+const (
+	// DefaultGMTFormat, DefaultGMTZeroFormat and DefaultHourFormat are the localized GMT format of root.
+	DefaultGMTFormat     = "GMT{0}"
+	DefaultGMTZeroFormat = "GMT"
+	DefaultHourFormat    = "+HH:mm;-HH:mm"
+)
+
+// CalendarNames are localized calendar names by context (format, stand-alone), width (abbreviated, narrow, short, wide) and key.
+// Example: Months["format"]["wide"]["1"] = "January".
+type CalendarNames map[string]map[string]map[string]string
+
+// CalendarFormat stores the CLDR Gregorian calendar patterns and names of a locale.
+// DateFormats, TimeFormats and DateTimeFormats are keyed by style (full, long, medium, short),
+// AvailableFormats by skeleton (e.g. yMMMd) and Eras by width (eraNames, eraAbbr, eraNarrow).
+// GMTFormat, GMTZeroFormat and HourFormat are the localized GMT format of time zones, e.g. "UTC{0}", "UTC" and "+HH:mm;-HH:mm".
+type CalendarFormat struct {
+	LocaleID         string                       `gorm:"primaryKey;size:32"`
+	DateFormats      map[string]string            `gorm:"serializer:json;type:jsonb;not null"`
+	TimeFormats      map[string]string            `gorm:"serializer:json;type:jsonb;not null"`
+	DateTimeFormats  map[string]string            `gorm:"serializer:json;type:jsonb;not null"`
+	AvailableFormats map[string]string            `gorm:"serializer:json;type:jsonb;not null"`
+	Months           CalendarNames                `gorm:"serializer:json;type:jsonb;not null"`
+	Days             CalendarNames                `gorm:"serializer:json;type:jsonb;not null"`
+	Quarters         CalendarNames                `gorm:"serializer:json;type:jsonb;not null"`
+	DayPeriods       CalendarNames                `gorm:"serializer:json;type:jsonb;not null"`
+	Eras             map[string]map[string]string `gorm:"serializer:json;type:jsonb;not null"`
+	GMTFormat        string                       `gorm:"size:32;not null;default:'GMT{0}'"`
+	GMTZeroFormat    string                       `gorm:"size:32;not null;default:'GMT'"`
+	HourFormat       string                       `gorm:"size:32;not null;default:'+HH:mm;-HH:mm'"`
+
+	// Relationships.
+	Locale Locale `gorm:"foreignKey:LocaleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
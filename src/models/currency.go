@@ -0,0 +1,14 @@
+package models
+
+import "database/sql"
+
+// Currency represents an ISO 4217 currency with its number of fraction digits.
+// Rounding is the rounding increment in units of the last fraction digit, 0 for none.
+// Cash amounts may use other digits and rounding (e.g. CHF cash rounds by 5); without them the standard ones apply.
+type Currency struct {
+	ID           string `gorm:"primaryKey;size:3"`
+	Digits       int    `gorm:"not null;default:2"`
+	Rounding     int    `gorm:"not null;default:0"`
+	CashDigits   sql.NullInt64
+	CashRounding sql.NullInt64
+}
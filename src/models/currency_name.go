@@ -0,0 +1,14 @@
+package models
+
+// CurrencyName represents the localized name and symbols of a currency.
+type CurrencyName struct {
+	CurrencyID   string `gorm:"primaryKey;size:3"`
+	LocaleID     string `gorm:"primaryKey;size:32"`
+	Name         string `gorm:"not null"`
+	Symbol       string `gorm:"not null"`
+	NarrowSymbol string `gorm:"not null"`
+
+	// Relationships.
+	Currency Currency `gorm:"foreignKey:CurrencyID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Locale   Locale   `gorm:"foreignKey:LocaleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
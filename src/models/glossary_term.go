@@ -0,0 +1,24 @@
+package models
+
+import (
+	"database/sql"
+
+	"gorm.io/gorm"
+)
+
+// GlossaryTerm represents an approved source term in the glossary (termbase) of an app.
+// Example: AppName = "shop", LocaleID = "en", Term = "Checkout", DoNotTranslate = false.
+type GlossaryTerm struct {
+	gorm.Model
+	AppName        string `gorm:"not null;index:idx_glossary_app_locale_term,unique,priority:1"`
+	LocaleID       string `gorm:"not null;size:32;index:idx_glossary_app_locale_term,unique,priority:2"`
+	Term           string `gorm:"not null;index:idx_glossary_app_locale_term,unique,priority:3"`
+	Description    sql.NullString
+	DoNotTranslate bool `gorm:"not null;default:false"`
+	CaseSensitive  bool `gorm:"not null;default:false"`
+
+	// Relationships.
+	App          App                       `gorm:"foreignKey:AppName;references:Name;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Locale       Locale                    `gorm:"foreignKey:LocaleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Translations []GlossaryTermTranslation `gorm:"foreignKey:GlossaryTermID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// GlossaryTermTranslation stores the approved translation of a glossary term for a locale.
+type GlossaryTermTranslation struct {
+	GlossaryTermID uint   `gorm:"primaryKey"`
+	LocaleID       string `gorm:"primaryKey;size:32"`
+	Value          string `gorm:"not null"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+
+	// Relationships.
+	GlossaryTerm GlossaryTerm `gorm:"foreignKey:GlossaryTermID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Locale       Locale       `gorm:"foreignKey:LocaleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
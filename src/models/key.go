@@ -13,9 +13,15 @@ type Key struct {
 	CategoryID  sql.Null[uint] `gorm:"index:idx_app_category_name,unique,priority:2"`
 	Name        string         `gorm:"not null;index:idx_app_category_name,unique,priority:3"`
 	Description sql.NullString
+	// Length limits of the translation values; per-locale overrides are in LengthLimits.
+	MaxCharacters sql.Null[int]
+	MaxGraphemes  sql.Null[int]
+	MaxLines      sql.Null[int]
 
 	// Relationships.
 	App          App              `gorm:"foreignKey:AppName;references:Name;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	Category     *Category        `gorm:"foreignKey:CategoryID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	Translations []KeyTranslation `gorm:"foreignKey:KeyID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Screenshots  []KeyScreenshot  `gorm:"foreignKey:KeyID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	LengthLimits []KeyLengthLimit `gorm:"foreignKey:KeyID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
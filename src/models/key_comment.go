@@ -0,0 +1,28 @@
+package models
+
+import (
+	"database/sql"
+
+	"gorm.io/gorm"
+)
+
+// KeyComment is a comment on a key, optionally scoped to one locale.
+// Comments without a parent start a thread; replies refer to the thread with ParentID.
+// Only threads are resolved and reopened.
+type KeyComment struct {
+	gorm.Model
+	KeyID      uint           `gorm:"not null;index"`
+	LocaleID   sql.NullString `gorm:"size:32"`
+	ParentID   sql.Null[uint] `gorm:"index"`
+	Author     string         `gorm:"not null"`
+	Body       string         `gorm:"not null"`
+	ResolvedAt sql.NullTime
+	ResolvedBy sql.NullString
+
+	// Relationships.
+	Key      Key                 `gorm:"foreignKey:KeyID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Locale   *Locale             `gorm:"foreignKey:LocaleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Parent   *KeyComment         `gorm:"foreignKey:ParentID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Replies  []KeyComment        `gorm:"foreignKey:ParentID;references:ID"`
+	Mentions []KeyCommentMention `gorm:"foreignKey:KeyCommentID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,10 @@
+package models
+
+// KeyCommentMention is a user mentioned in a key comment.
+type KeyCommentMention struct {
+	KeyCommentID uint   `gorm:"primaryKey"`
+	Mention      string `gorm:"primaryKey;index"`
+
+	// Relationships.
+	KeyComment KeyComment `gorm:"foreignKey:KeyCommentID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
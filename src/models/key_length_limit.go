@@ -0,0 +1,17 @@
+package models
+
+import "database/sql"
+
+// KeyLengthLimit overrides the length limits of a key for one locale.
+// Limits that are not set fall back to the limits of the key.
+type KeyLengthLimit struct {
+	KeyID         uint   `gorm:"primaryKey"`
+	LocaleID      string `gorm:"primaryKey;size:32"`
+	MaxCharacters sql.Null[int]
+	MaxGraphemes  sql.Null[int]
+	MaxLines      sql.Null[int]
+
+	// Relationships.
+	Key    Key    `gorm:"foreignKey:KeyID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Locale Locale `gorm:"foreignKey:LocaleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
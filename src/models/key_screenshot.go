@@ -0,0 +1,28 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+)
+
+// KeyScreenshot is a context screenshot that shows where a key is used.
+// The optional region highlights the position of the string on the screenshot in pixels.
+type KeyScreenshot struct {
+	ID           uint   `gorm:"primaryKey"`
+	KeyID        uint   `gorm:"not null;index"`
+	Path         string `gorm:"not null;uniqueIndex"`
+	FileName     string `gorm:"not null"`
+	ContentType  string `gorm:"not null"`
+	Size         int64  `gorm:"not null"`
+	Width        int    `gorm:"not null"`
+	Height       int    `gorm:"not null"`
+	RegionX      sql.Null[int]
+	RegionY      sql.Null[int]
+	RegionWidth  sql.Null[int]
+	RegionHeight sql.Null[int]
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+
+	// Relationships.
+	Key Key `gorm:"foreignKey:KeyID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
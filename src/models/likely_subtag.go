@@ -0,0 +1,8 @@
+package models
+
+// LikelySubtag maps a partial language tag to its most likely full tag.
+// Examples: zh-TW = zh-Hant-TW, sr = sr-Cyrl-RS, und-Arab = ar-Arab-EG.
+type LikelySubtag struct {
+	Tag       string `gorm:"primaryKey;size:32"`
+	Maximized string `gorm:"size:32;not null"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import "api-i18n/main/src/enums"
+
+// ListPattern stores the CLDR patterns to join a list of items of a locale in one type and width.
+// Two joins a list of two items, Start, Middle and End join the items of longer lists.
+type ListPattern struct {
+	LocaleID string            `gorm:"primaryKey;size:32"`
+	Type     enums.ListType    `gorm:"primaryKey;size:8"`
+	Width    enums.FormatWidth `gorm:"primaryKey;size:8"`
+	Start    string            `gorm:"not null"`
+	Middle   string            `gorm:"not null"`
+	End      string            `gorm:"not null"`
+	Two      string            `gorm:"not null"`
+
+	// Relationships.
+	Locale Locale `gorm:"foreignKey:LocaleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,13 @@
+package models
+
+import "api-i18n/main/src/enums"
+
+// LocaleAlias maps a deprecated or legacy subtag to its replacement.
+// Examples: language iw = he, language sh = sr-Latn, territory DD = DE.
+// Replacement may hold several space-separated territories, e.g. SU = RU AM AZ ...; the first is the default.
+type LocaleAlias struct {
+	Type        enums.AliasType `gorm:"primaryKey;size:16"`
+	Alias       string          `gorm:"primaryKey;size:32"`
+	Replacement string          `gorm:"not null"`
+	Reason      string          `gorm:"size:16"`
+}
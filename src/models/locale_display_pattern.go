@@ -0,0 +1,15 @@
+package models
+
+// LocaleDisplayPattern stores the CLDR patterns to compose locale display names in a locale.
+// Pattern combines the language with its qualifiers, e.g. "{0} ({1})", Separator joins the qualifiers, e.g. "{0}, {1}".
+// ShortNames holds the short language names by language tag, e.g. "en-GB" = "UK English".
+type LocaleDisplayPattern struct {
+	LocaleID       string            `gorm:"primaryKey;size:32"`
+	Pattern        string            `gorm:"not null"`
+	Separator      string            `gorm:"not null"`
+	KeyTypePattern string            `gorm:"not null"`
+	ShortNames     map[string]string `gorm:"serializer:json;type:jsonb;not null"`
+
+	// Relationships.
+	Locale Locale `gorm:"foreignKey:LocaleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
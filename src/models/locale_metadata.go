@@ -0,0 +1,20 @@
+package models
+
+import (
+	"api-i18n/main/src/enums"
+	"database/sql"
+)
+
+// LocaleMetadata stores the CLDR metadata of a locale: its text direction, coverage level (e.g. modern, moderate, basic),
+// default numbering system and parent locale. Locales without parent inherit from root.
+type LocaleMetadata struct {
+	LocaleID          string          `gorm:"primaryKey;size:32"`
+	Direction         enums.Direction `gorm:"size:3;default:ltr;not null"`
+	CoverageLevel     sql.NullString  `gorm:"size:16"`
+	NumberingSystemID sql.NullString  `gorm:"size:16"`
+	ParentLocaleID    sql.NullString  `gorm:"size:32"`
+
+	// Relationships.
+	Locale       Locale  `gorm:"foreignKey:LocaleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	ParentLocale *Locale `gorm:"foreignKey:ParentLocaleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+}
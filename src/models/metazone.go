@@ -0,0 +1,6 @@
+package models
+
+// Metazone represents a CLDR metazone: a group of time zones that share their names, e.g. America_Eastern.
+type Metazone struct {
+	ID string `gorm:"primaryKey;size:64"`
+}
@@ -0,0 +1,20 @@
+package models
+
+import "database/sql"
+
+// MetazoneName represents the localized long and short names of a metazone,
+// e.g. "Eastern Time", "Eastern Standard Time" and "Eastern Daylight Time".
+type MetazoneName struct {
+	MetazoneID    string `gorm:"primaryKey;size:64"`
+	LocaleID      string `gorm:"primaryKey;size:32"`
+	GenericLong   sql.NullString
+	StandardLong  sql.NullString
+	DaylightLong  sql.NullString
+	GenericShort  sql.NullString
+	StandardShort sql.NullString
+	DaylightShort sql.NullString
+
+	// Relationships.
+	Metazone Metazone `gorm:"foreignKey:MetazoneID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Locale   Locale   `gorm:"foreignKey:LocaleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,28 @@
+package models
+
+// NumberFormat stores the number symbols and patterns of a locale for one numbering system.
+// Default marks the default numbering system of the locale.
+type NumberFormat struct {
+	LocaleID              string `gorm:"primaryKey;size:32"`
+	NumberingSystemID     string `gorm:"primaryKey;size:16"`
+	Default               bool   `gorm:"not null;default:false"`
+	MinimumGroupingDigits int    `gorm:"not null;default:1"`
+	Decimal               string `gorm:"not null"`
+	Group                 string `gorm:"not null"`
+	PercentSign           string `gorm:"not null"`
+	PlusSign              string `gorm:"not null"`
+	MinusSign             string `gorm:"not null"`
+	PerMille              string `gorm:"not null"`
+	Exponential           string `gorm:"not null"`
+	Infinity              string `gorm:"not null"`
+	NaN                   string `gorm:"not null"`
+	DecimalPattern        string `gorm:"not null"`
+	PercentPattern        string `gorm:"not null"`
+	ScientificPattern     string `gorm:"not null"`
+	CurrencyPattern       string `gorm:"not null"`
+	AccountingPattern     string `gorm:"not null"`
+
+	// Relationships.
+	Locale          Locale          `gorm:"foreignKey:LocaleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	NumberingSystem NumberingSystem `gorm:"foreignKey:NumberingSystemID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
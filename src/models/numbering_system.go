@@ -0,0 +1,8 @@
+package models
+
+// NumberingSystem represents a CLDR numbering system with its decimal digits from zero to nine.
+// Examples: latn (0123456789), arab (٠١٢٣٤٥٦٧٨٩).
+type NumberingSystem struct {
+	ID     string `gorm:"primaryKey;size:16"`
+	Digits string `gorm:"not null"`
+}
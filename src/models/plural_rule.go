@@ -0,0 +1,18 @@
+package models
+
+import (
+	"api-i18n/main/src/enums"
+	"database/sql"
+)
+
+// PluralRule represents a CLDR plural rule of a language for one plural category.
+// LocaleID is the CLDR language or locale the rule applies to, e.g. en or pt-PT.
+// Rule is the condition without samples; the "other" category has an empty condition.
+type PluralRule struct {
+	LocaleID       string           `gorm:"primaryKey;size:32"`
+	Type           enums.PluralType `gorm:"primaryKey;type:plural_type"`
+	Category       string           `gorm:"primaryKey;size:8"`
+	Rule           string           `gorm:"not null"`
+	IntegerSamples sql.NullString
+	DecimalSamples sql.NullString
+}
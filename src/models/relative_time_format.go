@@ -0,0 +1,19 @@
+package models
+
+import "api-i18n/main/src/enums"
+
+// RelativeTimeFormat stores the CLDR relative time data of a date field (e.g. day) in one width.
+// Relative holds the named offsets, e.g. "-1" = "yesterday"; Future and Past hold the patterns
+// by plural category, e.g. Future["one"] = "in {0} day".
+type RelativeTimeFormat struct {
+	LocaleID    string            `gorm:"primaryKey;size:32"`
+	Unit        string            `gorm:"primaryKey;size:16"`
+	Width       enums.FormatWidth `gorm:"primaryKey;size:8"`
+	DisplayName string            `gorm:"not null"`
+	Relative    map[string]string `gorm:"serializer:json;type:jsonb;not null"`
+	Future      map[string]string `gorm:"serializer:json;type:jsonb;not null"`
+	Past        map[string]string `gorm:"serializer:json;type:jsonb;not null"`
+
+	// Relationships.
+	Locale Locale `gorm:"foreignKey:LocaleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -1,6 +1,10 @@
 package models
 
+import "api-i18n/main/src/enums"
+
 // Script represents an ISO 15924 script subtag (e.g. Latn, Cyrl, Hans, Hant).
+// Direction is the writing direction of the script, ltr or rtl.
 type Script struct {
-	ID string `gorm:"primaryKey;size:32"` // Title-case code
+	ID        string          `gorm:"primaryKey;size:32"` // Title-case code
+	Direction enums.Direction `gorm:"size:3;default:ltr;not null"`
 }
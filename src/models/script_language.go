@@ -0,0 +1,12 @@
+package models
+
+// ScriptLanguage represents a language that is written in a script.
+// Secondary marks scripts that are used for the language but are not its main script, e.g. Shaw for en.
+type ScriptLanguage struct {
+	ScriptID   string `gorm:"primaryKey;size:32"`
+	LanguageID string `gorm:"primaryKey;size:32"`
+	Secondary  bool   `gorm:"not null;default:false"`
+
+	// Relationships.
+	Script Script `gorm:"foreignKey:ScriptID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
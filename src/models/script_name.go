@@ -9,4 +9,6 @@ type ScriptName struct {
 	// Relationships.
 	Script Script `gorm:"foreignKey:ScriptID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	Locale Locale `gorm:"foreignKey:LocaleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	// Languages that use the script; the constraint is owned by ScriptLanguage.
+	Languages []ScriptLanguage `gorm:"foreignKey:ScriptID;references:ScriptID;constraint:-"`
 }
@@ -0,0 +1,11 @@
+package models
+
+// Subdivision represents an ISO 3166-2 subdivision of a territory, like a province or state.
+// Examples: US-CA, NL-NH, GB-ENG.
+type Subdivision struct {
+	ID          string `gorm:"primaryKey;size:16"`
+	TerritoryID string `gorm:"size:32;not null;index"`
+
+	// Relationships.
+	Territory Territory `gorm:"foreignKey:TerritoryID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,12 @@
+package models
+
+// SubdivisionName represents a localized subdivision name for a given language/locale.
+type SubdivisionName struct {
+	SubdivisionID string `gorm:"primaryKey;size:16"`
+	LocaleID      string `gorm:"primaryKey;size:32"`
+	Name          string `gorm:"not null"`
+
+	// Relationships.
+	Subdivision Subdivision `gorm:"foreignKey:SubdivisionID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Locale      Locale      `gorm:"foreignKey:LocaleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
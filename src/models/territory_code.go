@@ -0,0 +1,14 @@
+package models
+
+import "database/sql"
+
+// TerritoryCode stores the ISO 3166-1 alpha-3 and numeric codes of a territory from the CLDR code mappings.
+// Example: TerritoryID = "NL", Alpha3 = "NLD", Numeric = "528". UN M.49 regions only have their numeric code.
+type TerritoryCode struct {
+	TerritoryID string         `gorm:"primaryKey;size:32"`
+	Alpha3      sql.NullString `gorm:"size:3;index"`
+	Numeric     sql.NullString `gorm:"size:3;index"`
+
+	// Relationships.
+	Territory Territory `gorm:"foreignKey:TerritoryID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
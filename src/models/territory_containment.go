@@ -0,0 +1,13 @@
+package models
+
+// TerritoryContainment represents a CLDR territory containment: a region like 419 or a grouping like EU contains a territory.
+// Grouping marks containments of groupings (EU, EZ, UN) that are not part of the UN M.49 hierarchy.
+type TerritoryContainment struct {
+	ParentID string `gorm:"primaryKey;size:32"`
+	ChildID  string `gorm:"primaryKey;size:32;index"`
+	Grouping bool   `gorm:"not null;default:false"`
+
+	// Relationships.
+	Parent Territory `gorm:"foreignKey:ParentID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Child  Territory `gorm:"foreignKey:ChildID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
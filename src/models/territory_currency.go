@@ -0,0 +1,19 @@
+package models
+
+import "database/sql"
+
+// TerritoryCurrency represents a currency used in a territory between From and To.
+// Currencies without To are still in use; Tender is false for non-legal tender like USN.
+// Priority is the CLDR order of the currencies of a territory, the primary currency first.
+type TerritoryCurrency struct {
+	TerritoryID string `gorm:"primaryKey;size:32"`
+	CurrencyID  string `gorm:"primaryKey;size:3"`
+	From        sql.NullTime
+	To          sql.NullTime
+	Tender      bool `gorm:"not null;default:true"`
+	Priority    int  `gorm:"not null;default:0"`
+
+	// Relationships.
+	Territory Territory `gorm:"foreignKey:TerritoryID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Currency  Currency  `gorm:"foreignKey:CurrencyID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,23 @@
+package models
+
+import "database/sql"
+
+// TerritoryInfo stores the CLDR supplemental data of a territory: population, week data and measurement data.
+// Week and measurement data are resolved, territories without own data use the world (001) defaults.
+// FirstDay, WeekendStart and WeekendEnd are CLDR day keys (mon, tue, ...), MeasurementSystem is metric, US or UK
+// and PaperSize is A4 or US-Letter.
+type TerritoryInfo struct {
+	TerritoryID       string `gorm:"primaryKey;size:32"`
+	Population        sql.Null[int64]
+	FirstDay          string `gorm:"size:3;not null"`
+	MinDays           int    `gorm:"not null;default:1"`
+	WeekendStart      string `gorm:"size:3;not null"`
+	WeekendEnd        string `gorm:"size:3;not null"`
+	MeasurementSystem string `gorm:"size:16;not null"`
+	PaperSize         string `gorm:"size:16;not null"`
+
+	// Relationships.
+	Territory  Territory           `gorm:"foreignKey:TerritoryID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Languages  []TerritoryLanguage `gorm:"foreignKey:TerritoryID;references:TerritoryID"`
+	Currencies []TerritoryCurrency `gorm:"foreignKey:TerritoryID;references:TerritoryID"`
+}
@@ -0,0 +1,16 @@
+package models
+
+import "database/sql"
+
+// TerritoryLanguage represents a language spoken in a territory with its share of the population.
+// LanguageID is a CLDR language tag, e.g. en or zh-Hant. OfficialStatus is official, de_facto_official
+// or official_regional, or empty for languages without official status.
+type TerritoryLanguage struct {
+	TerritoryID       string         `gorm:"primaryKey;size:32"`
+	LanguageID        string         `gorm:"primaryKey;size:32"`
+	PopulationPercent float64        `gorm:"not null"`
+	OfficialStatus    sql.NullString `gorm:"size:32"`
+
+	// Relationships.
+	Territory Territory `gorm:"foreignKey:TerritoryID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -9,4 +9,6 @@ type TerritoryName struct {
 	// Relationships.
 	Territory Territory `gorm:"foreignKey:TerritoryID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	Locale    Locale    `gorm:"foreignKey:LocaleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	// Codes of the territory; the constraint is owned by TerritoryCode.
+	Code *TerritoryCode `gorm:"foreignKey:TerritoryID;references:TerritoryID;constraint:-"`
 }
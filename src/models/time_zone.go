@@ -0,0 +1,15 @@
+package models
+
+import "database/sql"
+
+// TimeZone represents a CLDR canonical IANA time zone, e.g. America/New_York.
+// MetazoneID is the metazone the time zone currently uses.
+type TimeZone struct {
+	ID          string         `gorm:"primaryKey;size:64"`
+	TerritoryID sql.NullString `gorm:"size:32"`
+	MetazoneID  sql.NullString `gorm:"size:64"`
+
+	// Relationships.
+	Territory *Territory `gorm:"foreignKey:TerritoryID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	Metazone  *Metazone  `gorm:"foreignKey:MetazoneID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+}
@@ -0,0 +1,14 @@
+package models
+
+// TimeZoneName represents the localized names of a time zone.
+// GenericName is the name without daylight saving, e.g. "Eastern Time" or "Germany Time".
+type TimeZoneName struct {
+	TimeZoneID   string `gorm:"primaryKey;size:64"`
+	LocaleID     string `gorm:"primaryKey;size:32"`
+	ExemplarCity string `gorm:"not null"`
+	GenericName  string `gorm:"not null"`
+
+	// Relationships.
+	TimeZone TimeZone `gorm:"foreignKey:TimeZoneID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Locale   Locale   `gorm:"foreignKey:LocaleID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"database/sql"
+
+	"gorm.io/gorm"
+)
+
+// Webhook is an URL of an app that receives the events it subscribed to.
+// Events is a comma separated list of event types, e.g. "comment.created,comment.resolved".
+type Webhook struct {
+	gorm.Model
+	AppName string `gorm:"not null;index"`
+	URL     string `gorm:"not null"`
+	Secret  sql.NullString
+	Events  string `gorm:"not null"`
+
+	// Relationships.
+	App App `gorm:"foreignKey:AppName;references:Name;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -2,6 +2,7 @@ package routes
 
 import (
 	"api-i18n/main/src/controllers"
+	"api-i18n/main/src/storage"
 
 	"github.com/ArnoldPMolenaar/api-utils/middleware"
 	"github.com/gofiber/fiber/v2"
@@ -31,8 +32,42 @@ func PrivateRoutes(a *fiber.App) {
 	keys := route.Group("/keys", middleware.MachineProtected())
 	keys.Get("/", controllers.GetKeys)
 	keys.Post("/", controllers.CreateKey)
+	keys.Get("/search", controllers.SearchKeys)
+	keys.Get("/export/xliff", controllers.ExportKeysXLIFF)
+	keys.Get("/length-violations", controllers.GetKeyLengthViolations)
 	keys.Get("/:id", controllers.GetKeyByID)
 	keys.Put("/:id", controllers.UpdateKey)
 	keys.Delete("/:id", controllers.DeleteKey)
 	keys.Put("/:id/restore", controllers.RestoreKey)
+	keys.Get("/:id/screenshots", controllers.GetKeyScreenshots)
+	keys.Post("/:id/screenshots", controllers.CreateKeyScreenshot)
+	keys.Delete("/:id/screenshots/:screenshotId", controllers.DeleteKeyScreenshot)
+	keys.Get("/:id/comments", controllers.GetKeyComments)
+	keys.Post("/:id/comments", controllers.CreateKeyComment)
+	keys.Delete("/:id/comments/:commentId", controllers.DeleteKeyComment)
+	keys.Put("/:id/comments/:commentId/resolve", controllers.ResolveKeyComment)
+	keys.Put("/:id/comments/:commentId/reopen", controllers.ReopenKeyComment)
+
+	// Register route group for /v1/glossary.
+	glossary := route.Group("/glossary", middleware.MachineProtected())
+	glossary.Get("/", controllers.GetGlossaryTerms)
+	glossary.Post("/", controllers.CreateGlossaryTerm)
+	glossary.Get("/compliance", controllers.GetGlossaryCompliance)
+	glossary.Get("/export", controllers.ExportGlossaryTBX)
+	glossary.Post("/import", controllers.ImportGlossaryTBX)
+	glossary.Get("/:id", controllers.GetGlossaryTermByID)
+	glossary.Put("/:id", controllers.UpdateGlossaryTerm)
+	glossary.Delete("/:id", controllers.DeleteGlossaryTerm)
+
+	// Register route group for /v1/webhooks.
+	webhooks := route.Group("/webhooks", middleware.MachineProtected())
+	webhooks.Get("/", controllers.GetWebhooks)
+	webhooks.Post("/", controllers.CreateWebhook)
+	webhooks.Delete("/:id", controllers.DeleteWebhook)
+
+	// Serve the files of the local storage under /v1/files.
+	if localStorage, ok := storage.Files.(*storage.LocalStorage); ok {
+		files := a.Group(storage.LocalStoragePublicURL, middleware.MachineProtected())
+		files.Static("/", localStorage.Root())
+	}
 }
@@ -18,10 +18,52 @@ func PublicRoutes(a *fiber.App) {
 	// Register route group for /v1/territories.
 	territories := route.Group("/territories")
 	territories.Get("/lookup", controllers.GetTerritoryLookup)
+	territories.Get("/convert", controllers.ConvertTerritoryCode)
+	territories.Get("/:id", controllers.GetTerritory)
+	territories.Get("/:id/children", controllers.GetTerritoryChildren)
+	territories.Get("/:id/ancestors", controllers.GetTerritoryAncestors)
+	territories.Get("/:id/subdivisions", controllers.GetTerritorySubdivisions)
+
+	// Register route group for /v1/scripts.
+	scripts := route.Group("/scripts")
+	scripts.Get("/lookup", controllers.GetScriptLookup)
+
+	// Register route group for /v1/variants.
+	variants := route.Group("/variants")
+	variants.Get("/lookup", controllers.GetVariantLookup)
+
+	// Register route group for /v1/timezones.
+	timeZones := route.Group("/timezones")
+	timeZones.Get("/lookup", controllers.GetTimeZoneLookup)
 
 	// Register route group for /v1/locales.
 	locales := route.Group("/locales")
 	locales.Get("/lookup", controllers.GetLocaleLookup)
+	locales.Get("/:id", controllers.GetLocale)
+	locales.Get("/:id/plural-rules", controllers.GetPluralRules)
+	locales.Get("/:id/plural-rules/evaluate", controllers.EvaluatePluralRule)
+
+	// Register route group for /v1/numbers.
+	numbers := route.Group("/numbers")
+	numbers.Get("/format", controllers.FormatNumber)
+	numbers.Get("/parse", controllers.ParseNumber)
+
+	// Register route group for /v1/dates.
+	dates := route.Group("/dates")
+	dates.Get("/format", controllers.FormatDate)
+	dates.Get("/patterns", controllers.GetDatePatterns)
+
+	// Register route group for /v1/relative-times.
+	relativeTimes := route.Group("/relative-times")
+	relativeTimes.Get("/format", controllers.FormatRelativeTime)
+
+	// Register route group for /v1/lists.
+	lists := route.Group("/lists")
+	lists.Get("/format", controllers.FormatList)
+
+	// Register route group for /v1/currencies.
+	currencies := route.Group("/currencies")
+	currencies.Get("/lookup", controllers.GetCurrencyLookup)
 
 	// Register route group for /v1/translations.
 	translations := route.Group("/translations")
@@ -30,6 +72,10 @@ func PublicRoutes(a *fiber.App) {
 	// Register route group for /v1/phones.
 	phones := route.Group("/phones")
 	phones.Get("/lookup", controllers.GetPhoneLookup)
+	phones.Get("/lookup/:code", controllers.GetPhoneLookupByCode)
+	phones.Get("/metadata/:region", controllers.GetPhoneMetadata)
 	phones.Get("/validate", controllers.GetPhoneNumberValidation)
 	phones.Get("/format", controllers.GetPhoneNumberFormat)
+	phones.Get("/format/as-you-type", controllers.GetPhoneNumberAsYouType)
+	phones.Post("/batch", controllers.FormatPhoneNumbers)
 }
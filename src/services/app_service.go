@@ -3,6 +3,7 @@ package services
 import (
 	"api-i18n/main/src/database"
 	"api-i18n/main/src/models"
+	"database/sql"
 	"slices"
 	"time"
 )
@@ -38,6 +39,22 @@ func GetAppLocales(app string) ([]models.Locale, error) {
 	return a.Locales, nil
 }
 
+// GetAppSourceLocaleID method to get the source locale ID of an app.
+// Returns nil when the app has no source locale.
+func GetAppSourceLocaleID(app string) (*string, error) {
+	a := models.App{}
+
+	if result := database.Pg.Find(&a, "name = ?", app); result.Error != nil {
+		return nil, result.Error
+	}
+
+	if !a.SourceLocaleID.Valid {
+		return nil, nil
+	}
+
+	return &a.SourceLocaleID.String, nil
+}
+
 // CreateApp method to create an app.
 func CreateApp(name string) (*models.App, error) {
 	app := &models.App{Name: name}
@@ -52,7 +69,8 @@ func CreateApp(name string) (*models.App, error) {
 // SetAppLocales method to set the locales of an app.
 // It also restores existing translations for newly added locales
 // and deletes translations for removed locales.
-func SetAppLocales(app string, locales []string) error {
+// When sourceLocaleID is nil, the current source locale is kept as long as it is one of the new locales.
+func SetAppLocales(app string, locales []string, sourceLocaleID *string) error {
 	a := models.App{Name: app}
 	currentLocales, err := GetAppLocales(app)
 	if err != nil {
@@ -109,6 +127,23 @@ func SetAppLocales(app string, locales []string) error {
 		}
 	}
 
+	// Set the source locale.
+	currentSourceLocaleID, err := GetAppSourceLocaleID(app)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	sourceLocale := sql.NullString{}
+	if sourceLocaleID != nil {
+		sourceLocale = sql.NullString{String: *sourceLocaleID, Valid: true}
+	} else if currentSourceLocaleID != nil && slices.Contains(locales, *currentSourceLocaleID) {
+		sourceLocale = sql.NullString{String: *currentSourceLocaleID, Valid: true}
+	}
+	if err := tx.Model(&a).Update("source_locale_id", sourceLocale).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	// Delete translations for removed locales
 	for _, localeID := range currentLocaleIDs {
 		if slices.Contains(locales, localeID) {
@@ -9,7 +9,6 @@ import (
 	"database/sql"
 	"encoding/json"
 	"os"
-	"strings"
 	"time"
 
 	"github.com/ArnoldPMolenaar/api-utils/pagination"
@@ -18,7 +17,7 @@ import (
 	"gorm.io/gorm"
 )
 
-// categoriesLookupCacheKey returns the key for the categories cache.
+// categoriesLookupCacheKey is the prefix of the keys for the categories cache, one per collation locale.
 const categoriesLookupCacheKey = "categories:lookup"
 
 // IsCategoryAvailable method to check if a category is available.
@@ -97,14 +96,14 @@ func GetCategories(c *fiber.Ctx) (*pagination.Model, error) {
 	return &paginationModel, nil
 }
 
-// GetCategoryLookup method to get a lookup of categories.
-func GetCategoryLookup(name *string) (*[]models.Category, error) {
+// GetCategoryLookup method to get a lookup of categories, sorted by name with the collation of a locale.
+func GetCategoryLookup(localeID string, name *string) (*[]models.Category, error) {
 	categories := make([]models.Category, 0)
 
-	if inCache, err := isCategoriesLookupInCache(); err != nil {
+	if inCache, err := isCategoriesLookupInCache(localeID); err != nil {
 		return nil, err
 	} else if inCache {
-		if cacheCategories, err := getCategoriesLookupFromCache(); err != nil {
+		if cacheCategories, err := getCategoriesLookupFromCache(localeID); err != nil {
 			return nil, err
 		} else if cacheCategories != nil && len(*cacheCategories) > 0 {
 			categories = *cacheCategories
@@ -119,22 +118,14 @@ func GetCategoryLookup(name *string) (*[]models.Category, error) {
 			return nil, result.Error
 		}
 
-		_ = setCategoriesLookupToCache(&categories)
+		sortByCollation(localeID, categories, func(c *models.Category) string { return c.Name })
+
+		_ = setCategoriesLookupToCache(localeID, &categories)
 	}
 
-	// If a name filter is provided, perform case-insensitive substring match on the list
+	// If a name filter is provided, match it ignoring case and accents, names starting with it first
 	if name != nil {
-		target := strings.TrimSpace(*name)
-		if target != "" {
-			lowerTarget := strings.ToLower(target)
-			filtered := make([]models.Category, 0, len(categories))
-			for i := range categories {
-				if strings.Contains(strings.ToLower(categories[i].Name), lowerTarget) {
-					filtered = append(filtered, categories[i])
-				}
-			}
-			categories = filtered
-		}
+		categories = filterByName(localeID, categories, *name, func(x *models.Category) string { return x.Name })
 	}
 
 	return &categories, nil
@@ -210,8 +201,8 @@ func RestoreCategory(categoryID uint) error {
 }
 
 // isCategoriesLookupInCache checks if the categories exists in the cache.
-func isCategoriesLookupInCache() (bool, error) {
-	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Exists().Key(categoriesLookupCacheKey).Build())
+func isCategoriesLookupInCache(localeID string) (bool, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Exists().Key(categoryLookupCacheKey(localeID)).Build())
 	if result.Error() != nil {
 		return false, result.Error()
 	}
@@ -225,8 +216,8 @@ func isCategoriesLookupInCache() (bool, error) {
 }
 
 // getCategoriesLookupFromCache gets the categories from the cache.
-func getCategoriesLookupFromCache() (*[]models.Category, error) {
-	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Get().Key(categoriesLookupCacheKey).Build())
+func getCategoriesLookupFromCache(localeID string) (*[]models.Category, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Get().Key(categoryLookupCacheKey(localeID)).Build())
 	if result.Error() != nil {
 		return nil, result.Error()
 	}
@@ -245,7 +236,7 @@ func getCategoriesLookupFromCache() (*[]models.Category, error) {
 }
 
 // setCategoriesLookupToCache sets the categories to the cache.
-func setCategoriesLookupToCache(categories *[]models.Category) error {
+func setCategoriesLookupToCache(localeID string, categories *[]models.Category) error {
 	value, err := json.Marshal(categories)
 	if err != nil {
 		return err
@@ -257,7 +248,7 @@ func setCategoriesLookupToCache(categories *[]models.Category) error {
 		return err
 	}
 
-	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Set().Key(categoriesLookupCacheKey).Value(valkey.BinaryString(value)).Ex(duration).Build())
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Set().Key(categoryLookupCacheKey(localeID)).Value(valkey.BinaryString(value)).Ex(duration).Build())
 	if result.Error() != nil {
 		return result.Error()
 	}
@@ -265,12 +256,35 @@ func setCategoriesLookupToCache(categories *[]models.Category) error {
 	return nil
 }
 
-// deleteCategoriesLookupFromCache deletes an existing categories from the cache.
+// deleteCategoriesLookupFromCache deletes the existing categories of all locales from the cache.
 func deleteCategoriesLookupFromCache() error {
-	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Del().Key(categoriesLookupCacheKey).Build())
-	if result.Error() != nil {
-		return result.Error()
+	var cursor uint64
+	for {
+		result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Scan().Cursor(cursor).Match(categoriesLookupCacheKey+":*").Count(100).Build())
+		if result.Error() != nil {
+			return result.Error()
+		}
+
+		entry, err := result.AsScanEntry()
+		if err != nil {
+			return err
+		}
+
+		if len(entry.Elements) > 0 {
+			result = cache.Valkey.Do(context.Background(), cache.Valkey.B().Del().Key(entry.Elements...).Build())
+			if result.Error() != nil {
+				return result.Error()
+			}
+		}
+
+		if entry.Cursor == 0 {
+			return nil
+		}
+		cursor = entry.Cursor
 	}
+}
 
-	return nil
+// categoryLookupCacheKey returns the key for the categories cache of a collation locale.
+func categoryLookupCacheKey(localeID string) string {
+	return categoriesLookupCacheKey + ":" + localeID
 }
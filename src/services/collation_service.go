@@ -0,0 +1,117 @@
+package services
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/search"
+)
+
+// Ranks of a name match; lower ranks come first.
+const (
+	matchPrefix = iota
+	matchWordPrefix
+	matchSubstring
+)
+
+// sortByCollation sorts items by name with the collation of a locale, e.g. "Åland" after "Zambia" in Swedish.
+// Unknown locales use the root collation.
+func sortByCollation[T any](localeID string, items []T, name func(*T) string) {
+	collator := collate.New(collationTag(localeID))
+
+	slices.SortStableFunc(items, func(a, b T) int {
+		return collator.CompareString(name(&a), name(&b))
+	})
+}
+
+// filterByName keeps the items with a name that contains the term, ignoring case and accents as the locale does,
+// so "curacao" finds "Curaçao". Names starting with the term come first, then names with a word starting with it;
+// within a rank the order of the items is kept. With several names, e.g. a name and a code, the best match counts.
+func filterByName[T any](localeID string, items []T, term string, names ...func(*T) string) []T {
+	term = strings.TrimSpace(term)
+	if term == "" {
+		return items
+	}
+
+	matcher := search.New(collationTag(localeID), search.Loose)
+	pattern := matcher.CompileString(term)
+
+	ranks := make(map[int]int, len(items))
+	filtered := make([]int, 0, len(items))
+	for i := range items {
+		best := -1
+		for _, name := range names {
+			if rank, ok := nameMatchRank(pattern, name(&items[i])); ok && (best < 0 || rank < best) {
+				best = rank
+			}
+		}
+		if best < 0 {
+			continue
+		}
+
+		ranks[i] = best
+		filtered = append(filtered, i)
+	}
+
+	slices.SortStableFunc(filtered, func(a, b int) int {
+		return ranks[a] - ranks[b]
+	})
+
+	result := make([]T, len(filtered))
+	for i, index := range filtered {
+		result[i] = items[index]
+	}
+
+	return result
+}
+
+// nameMatchRank returns how well a name matches the compiled term, or false when it doesn't contain the term.
+func nameMatchRank(pattern *search.Pattern, text string) (int, bool) {
+	start, _ := pattern.IndexString(text)
+	if start < 0 {
+		return 0, false
+	}
+
+	switch {
+	case start == 0:
+		return matchPrefix, true
+	case isWordStart(text, start):
+		return matchWordPrefix, true
+	}
+
+	// A later occurrence may still start a word, e.g. "Bosnia and Herzegovina" for "her".
+	for offset := start; offset < len(text); {
+		_, size := utf8.DecodeRuneInString(text[offset:])
+		offset += size
+		next, _ := pattern.IndexString(text[offset:])
+		if next < 0 {
+			break
+		}
+		offset += next
+		if isWordStart(text, offset) {
+			return matchWordPrefix, true
+		}
+	}
+
+	return matchSubstring, true
+}
+
+// isWordStart checks if the text has a word starting at the byte offset.
+func isWordStart(text string, offset int) bool {
+	previous, _ := utf8.DecodeLastRuneInString(text[:offset])
+	return !unicode.IsLetter(previous) && !unicode.IsDigit(previous)
+}
+
+// collationTag returns the language tag of a locale for collation, or the root tag.
+func collationTag(localeID string) language.Tag {
+	tag, err := language.Parse(localeID)
+	if err != nil {
+		return language.Und
+	}
+
+	return tag
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFilterByName(t *testing.T) {
+	type zone struct{ id, name, city string }
+	zones := []zone{
+		{id: "Europe/Amsterdam", name: "Central European Time", city: "Amsterdam"},
+		{id: "America/Curacao", name: "Atlantic Time", city: "Curaçao"},
+		{id: "Europe/Sarajevo", name: "Central European Time", city: "Sarajevo"},
+		{id: "Asia/Kolkata", name: "India Standard Time", city: "Kolkata"},
+		{id: "Pacific/Guam", name: "Chamorro Standard Time", city: "Guam"},
+	}
+	ids := func(items []zone) []string {
+		result := make([]string, len(items))
+		for i := range items {
+			result[i] = items[i].id
+		}
+		return result
+	}
+
+	tests := []struct {
+		term string
+		want []string
+	}{
+		{term: "curacao", want: []string{"America/Curacao"}},
+		{term: "CENTRAL", want: []string{"Europe/Amsterdam", "Europe/Sarajevo"}},
+		{term: "standard", want: []string{"Asia/Kolkata", "Pacific/Guam"}},
+		{term: "ams", want: []string{"Europe/Amsterdam"}},
+		{term: "europe", want: []string{"Europe/Amsterdam", "Europe/Sarajevo"}},
+		{term: "kata", want: []string{"Asia/Kolkata"}},
+		{term: "am", want: []string{"Europe/Amsterdam", "America/Curacao", "Pacific/Guam"}},
+		{term: " ", want: ids(zones)},
+		{term: "xyz", want: []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.term, func(t *testing.T) {
+			got := filterByName("en", zones, test.term,
+				func(z *zone) string { return z.name },
+				func(z *zone) string { return z.city },
+				func(z *zone) string { return z.id })
+			if !slices.Equal(ids(got), test.want) {
+				t.Errorf("filterByName(%q) = %v, want %v", test.term, ids(got), test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"api-i18n/main/src/database"
+	"api-i18n/main/src/dto/requests"
+	"api-i18n/main/src/models"
+	"database/sql"
+	"regexp"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// commentMention matches @mentions in a comment body.
+var commentMention = regexp.MustCompile(`(?:^|[^\w@])@([\w.-]*\w)`)
+
+// GetKeyComments method to get the comment threads of a key with their replies.
+// When localeID is set, only threads of that locale are returned; resolved filters on the thread state.
+func GetKeyComments(keyID uint, localeID *string, resolved *bool) (*[]models.KeyComment, error) {
+	comments := make([]models.KeyComment, 0)
+
+	query := database.Pg.
+		Preload("Mentions").
+		Preload("Replies", func(db *gorm.DB) *gorm.DB { return db.Order("key_comments.id") }).
+		Preload("Replies.Mentions").
+		Where("key_id = ? AND parent_id IS NULL", keyID)
+	if localeID != nil {
+		query = query.Where("locale_id = ?", *localeID)
+	}
+	if resolved != nil {
+		if *resolved {
+			query = query.Where("resolved_at IS NOT NULL")
+		} else {
+			query = query.Where("resolved_at IS NULL")
+		}
+	}
+
+	if result := query.Order("id").Find(&comments); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &comments, nil
+}
+
+// GetKeyCommentByID method to get a comment of a key by ID.
+func GetKeyCommentByID(keyID, commentID uint) (*models.KeyComment, error) {
+	comment := &models.KeyComment{}
+
+	if result := database.Pg.
+		Preload("Mentions").
+		Preload("Replies", func(db *gorm.DB) *gorm.DB { return db.Order("key_comments.id") }).
+		Preload("Replies.Mentions").
+		Find(comment, "key_id = ? AND id = ?", keyID, commentID); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return comment, nil
+}
+
+// CreateKeyComment method to create a comment on a key.
+// Replies inherit the locale of their thread. Mentions are the explicit mentions of the request
+// together with the @mentions in the body.
+func CreateKeyComment(keyID uint, author string, commentDto requests.CreateKeyComment, parent *models.KeyComment) (*models.KeyComment, error) {
+	comment := &models.KeyComment{
+		KeyID:  keyID,
+		Author: author,
+		Body:   commentDto.Body,
+	}
+	if parent != nil {
+		comment.ParentID = sql.Null[uint]{V: parent.ID, Valid: true}
+		comment.LocaleID = parent.LocaleID
+	} else if commentDto.LocaleID != nil {
+		comment.LocaleID = sql.NullString{String: *commentDto.LocaleID, Valid: true}
+	}
+
+	for _, mention := range commentMentions(commentDto.Body, commentDto.Mentions) {
+		comment.Mentions = append(comment.Mentions, models.KeyCommentMention{Mention: mention})
+	}
+
+	if result := database.Pg.Create(comment); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return comment, nil
+}
+
+// ResolveKeyComment method to resolve a comment thread.
+func ResolveKeyComment(comment *models.KeyComment, resolvedBy string) error {
+	comment.ResolvedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	comment.ResolvedBy = sql.NullString{String: resolvedBy, Valid: true}
+
+	return database.Pg.Model(comment).Select("ResolvedAt", "ResolvedBy").Updates(comment).Error
+}
+
+// ReopenKeyComment method to reopen a resolved comment thread.
+func ReopenKeyComment(comment *models.KeyComment) error {
+	comment.ResolvedAt = sql.NullTime{}
+	comment.ResolvedBy = sql.NullString{}
+
+	return database.Pg.Model(comment).Select("ResolvedAt", "ResolvedBy").Updates(comment).Error
+}
+
+// DeleteKeyComment method to delete a comment and, when it starts a thread, its replies.
+func DeleteKeyComment(comment *models.KeyComment) error {
+	return database.Pg.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("parent_id = ?", comment.ID).Delete(&models.KeyComment{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(comment).Error
+	})
+}
+
+// scopeUnresolvedComments filters keys with at least one unresolved comment thread.
+func scopeUnresolvedComments(db *gorm.DB) *gorm.DB {
+	return db.Where("EXISTS (SELECT 1 FROM key_comments WHERE key_comments.key_id = keys.id " +
+		"AND key_comments.parent_id IS NULL AND key_comments.resolved_at IS NULL AND key_comments.deleted_at IS NULL)")
+}
+
+// commentMentions returns the unique mentions of a comment.
+func commentMentions(body string, mentions []string) []string {
+	result := make([]string, 0)
+	seen := make(map[string]bool)
+
+	add := func(mention string) {
+		mention = strings.TrimPrefix(strings.TrimSpace(mention), "@")
+		if mention == "" || seen[strings.ToLower(mention)] {
+			return
+		}
+		seen[strings.ToLower(mention)] = true
+		result = append(result, mention)
+	}
+
+	for _, mention := range mentions {
+		add(mention)
+	}
+	for _, match := range commentMention.FindAllStringSubmatch(body, -1) {
+		add(match[1])
+	}
+
+	return result
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"api-i18n/main/src/cache"
+	"api-i18n/main/src/database"
+	"api-i18n/main/src/models"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+)
+
+// GetCurrenciesLookup method to get currencies lookup by locale ID and optional name filter.
+// The name filter matches the localized name and the currency code.
+func GetCurrenciesLookup(localeID string, name *string) (*[]models.CurrencyName, error) {
+	currencies := make([]models.CurrencyName, 0)
+
+	if inCache, err := isCurrenciesLookupInCache(localeID); err != nil {
+		return nil, err
+	} else if inCache {
+		if cacheCurrencies, err := getCurrenciesLookupFromCache(localeID); err != nil {
+			return nil, err
+		} else if cacheCurrencies != nil && len(*cacheCurrencies) > 0 {
+			currencies = *cacheCurrencies
+		}
+	}
+
+	if len(currencies) == 0 {
+		query := database.Pg.Model(&models.CurrencyName{}).
+			Preload("Currency")
+
+		if result := query.Find(&currencies, "locale_id = ?", localeID); result.Error != nil {
+			return nil, result.Error
+		}
+
+		_ = setCurrenciesLookupToCache(localeID, &currencies)
+	}
+
+	// If a name filter is provided, match it ignoring case and accents against the name and code, names starting with it first
+	if name != nil {
+		currencies = filterByName(localeID, currencies, *name,
+			func(x *models.CurrencyName) string { return x.Name },
+			func(x *models.CurrencyName) string { return x.CurrencyID })
+	}
+
+	return &currencies, nil
+}
+
+// isCurrenciesLookupInCache checks if the currencies exists in the cache.
+func isCurrenciesLookupInCache(localeID string) (bool, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Exists().Key(currencyLookupCacheKey(localeID)).Build())
+	if result.Error() != nil {
+		return false, result.Error()
+	}
+
+	value, err := result.ToInt64()
+	if err != nil {
+		return false, err
+	}
+
+	return value == 1, nil
+}
+
+// getCurrenciesLookupFromCache gets the currencies from the cache.
+func getCurrenciesLookupFromCache(localeID string) (*[]models.CurrencyName, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Get().Key(currencyLookupCacheKey(localeID)).Build())
+	if result.Error() != nil {
+		return nil, result.Error()
+	}
+
+	value, err := result.ToString()
+	if err != nil {
+		return nil, err
+	}
+
+	var currencies []models.CurrencyName
+	if err := json.Unmarshal([]byte(value), &currencies); err != nil {
+		return nil, err
+	}
+
+	return &currencies, nil
+}
+
+// setCurrenciesLookupToCache sets the currencies to the cache.
+func setCurrenciesLookupToCache(localeID string, currencies *[]models.CurrencyName) error {
+	value, err := json.Marshal(currencies)
+	if err != nil {
+		return err
+	}
+
+	expiration := os.Getenv("VALKEY_EXPIRATION")
+	duration, err := time.ParseDuration(expiration)
+	if err != nil {
+		return err
+	}
+
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Set().Key(currencyLookupCacheKey(localeID)).Value(valkey.BinaryString(value)).Ex(duration).Build())
+	if result.Error() != nil {
+		return result.Error()
+	}
+
+	return nil
+}
+
+// currencyLookupCacheKey returns the key for the currencies cache.
+func currencyLookupCacheKey(localeID string) string {
+	return fmt.Sprintf("currencies:lookup:%s", localeID)
+}
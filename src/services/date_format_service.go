@@ -0,0 +1,607 @@
+package services
+
+import (
+	"api-i18n/main/src/database"
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata"
+)
+
+var (
+	// ErrCalendarFormatNotFound is returned when a locale has no calendar data.
+	ErrCalendarFormatNotFound = errors.New("calendar format not found")
+	// ErrDatePatternNotFound is returned when no pattern matches a style or skeleton.
+	ErrDatePatternNotFound = errors.New("date pattern not found")
+)
+
+// dateWeekdayKeys are the CLDR keys of the days of the week, starting at Sunday like time.Weekday.
+var dateWeekdayKeys = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// dateFieldTypes maps pattern letters to their field type, used to match skeletons.
+var dateFieldTypes = map[rune]rune{
+	'G': 'G',
+	'y': 'y', 'Y': 'y', 'u': 'y', 'U': 'y', 'r': 'y',
+	'Q': 'Q', 'q': 'Q',
+	'M': 'M', 'L': 'M',
+	'w': 'w', 'W': 'W',
+	'd': 'd', 'D': 'D', 'F': 'F', 'g': 'g',
+	'E': 'E', 'e': 'E', 'c': 'E',
+	'a': 'a', 'b': 'a', 'B': 'a',
+	'h': 'h', 'H': 'h', 'K': 'h', 'k': 'h', 'j': 'h', 'J': 'h', 'C': 'h',
+	'm': 'm',
+	's': 's', 'S': 'S', 'A': 'S',
+	'z': 'z', 'Z': 'z', 'O': 'z', 'v': 'z', 'V': 'z', 'X': 'z', 'x': 'z',
+}
+
+// dateTimeFieldTypes are the field types that belong to the time part of a skeleton.
+const dateTimeFieldTypes = "ahmsSz"
+
+// dateField is a field of a pattern or skeleton, e.g. MMMM.
+type dateField struct {
+	letter rune
+	count  int
+}
+
+// DateFormatOptions holds the style or skeleton of a date to format.
+type DateFormatOptions struct {
+	DateStyle *enums.DateStyle
+	TimeStyle *enums.DateStyle
+	Skeleton  *string
+}
+
+// GetCalendarFormat method to get the Gregorian calendar format of a locale.
+// Locales without calendar data fall back to their parent, e.g. de-CH uses de.
+func GetCalendarFormat(localeID string) (*models.CalendarFormat, error) {
+	calendarFormats := make([]models.CalendarFormat, 0)
+	candidates := localeFallbacks(localeID)
+
+	if result := database.Pg.Find(&calendarFormats, "locale_id IN ?", candidates); result.Error != nil {
+		return nil, result.Error
+	}
+
+	for _, candidate := range candidates {
+		for i := range calendarFormats {
+			if calendarFormats[i].LocaleID == candidate {
+				return &calendarFormats[i], nil
+			}
+		}
+	}
+
+	return nil, ErrCalendarFormatNotFound
+}
+
+// FormatDate method to format a timestamp for a locale and time zone by date/time style or skeleton.
+// Returns the formatted date, the pattern that was used and the calendar format of the locale.
+func FormatDate(localeID string, timestamp time.Time, location *time.Location, options DateFormatOptions) (string, string, *models.CalendarFormat, error) {
+	calendarFormat, err := GetCalendarFormat(localeID)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	pattern, err := datePattern(calendarFormat, options)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	digits := "0123456789"
+	if numberFormat, err := GetNumberFormat(calendarFormat.LocaleID, nil); err == nil {
+		digits = numberFormat.NumberingSystem.Digits
+	}
+
+	return formatDatePattern(pattern, timestamp.In(location), calendarFormat, digits), pattern, calendarFormat, nil
+}
+
+// datePattern returns the pattern of a calendar format for a date/time style or skeleton.
+func datePattern(calendarFormat *models.CalendarFormat, options DateFormatOptions) (string, error) {
+	if options.Skeleton != nil {
+		return skeletonPattern(calendarFormat, *options.Skeleton)
+	}
+
+	var datePattern, timePattern string
+	if options.DateStyle != nil {
+		datePattern = calendarFormat.DateFormats[options.DateStyle.String()]
+	}
+	if options.TimeStyle != nil {
+		timePattern = calendarFormat.TimeFormats[options.TimeStyle.String()]
+	}
+
+	switch {
+	case datePattern != "" && timePattern != "":
+		return combineDatePatterns(calendarFormat, options.DateStyle.String(), datePattern, timePattern), nil
+	case datePattern != "":
+		return datePattern, nil
+	case timePattern != "":
+		return timePattern, nil
+	default:
+		return "", ErrDatePatternNotFound
+	}
+}
+
+// combineDatePatterns combines a date and time pattern with the date-time pattern of a style.
+func combineDatePatterns(calendarFormat *models.CalendarFormat, style, datePattern, timePattern string) string {
+	glue, ok := calendarFormat.DateTimeFormats[style]
+	if !ok {
+		glue = "{1} {0}"
+	}
+
+	return strings.NewReplacer("{1}", datePattern, "{0}", timePattern).Replace(glue)
+}
+
+// skeletonPattern returns the best matching pattern of the available formats for a skeleton like "yMMMd" or "jm".
+// Skeletons without a match with the same fields are split into a date and time part that are matched separately.
+func skeletonPattern(calendarFormat *models.CalendarFormat, skeleton string) (string, error) {
+	fields := parseDateSkeleton(skeleton, calendarFormat)
+	if len(fields) == 0 {
+		return "", ErrDatePatternNotFound
+	}
+
+	if pattern, ok := matchDateSkeleton(calendarFormat, fields); ok {
+		return pattern, nil
+	}
+
+	dateFields, timeFields := make([]dateField, 0), make([]dateField, 0)
+	for _, field := range fields {
+		if strings.ContainsRune(dateTimeFieldTypes, dateFieldTypes[field.letter]) {
+			timeFields = append(timeFields, field)
+		} else {
+			dateFields = append(dateFields, field)
+		}
+	}
+	if len(dateFields) == 0 || len(timeFields) == 0 {
+		return "", ErrDatePatternNotFound
+	}
+
+	datePattern, ok := matchDateSkeleton(calendarFormat, dateFields)
+	if !ok {
+		return "", ErrDatePatternNotFound
+	}
+	timePattern, ok := matchDateSkeleton(calendarFormat, timeFields)
+	if !ok {
+		return "", ErrDatePatternNotFound
+	}
+
+	// The date-time style depends on the month and weekday width of the skeleton.
+	style := "short"
+	for _, field := range dateFields {
+		switch {
+		case dateFieldTypes[field.letter] == 'M' && field.count == 4:
+			style = "long"
+			for _, f := range dateFields {
+				if dateFieldTypes[f.letter] == 'E' {
+					style = "full"
+				}
+			}
+		case dateFieldTypes[field.letter] == 'M' && field.count == 3 && style == "short":
+			style = "medium"
+		}
+	}
+
+	return combineDatePatterns(calendarFormat, style, datePattern, timePattern), nil
+}
+
+// matchDateSkeleton finds the available format with the same field types as the requested fields and the smallest
+// difference in field lengths, and adjusts its field lengths to the requested ones.
+func matchDateSkeleton(calendarFormat *models.CalendarFormat, fields []dateField) (string, bool) {
+	requested := make(map[rune]dateField, len(fields))
+	for _, field := range fields {
+		requested[dateFieldTypes[field.letter]] = field
+	}
+
+	bestPattern, bestDistance := "", math.MaxInt
+	for skeleton, pattern := range calendarFormat.AvailableFormats {
+		if strings.Contains(skeleton, "-alt-") {
+			continue
+		}
+
+		available := parseDateSkeleton(skeleton, calendarFormat)
+		if len(available) != len(requested) {
+			continue
+		}
+
+		distance := 0
+		for _, field := range available {
+			want, ok := requested[dateFieldTypes[field.letter]]
+			if !ok {
+				distance = math.MaxInt
+				break
+			}
+			if field.letter != want.letter {
+				distance += 100
+			}
+			if (field.count >= 3) != (want.count >= 3) {
+				distance += 10
+			}
+			distance += abs(field.count - want.count)
+		}
+
+		if distance < bestDistance || (distance == bestDistance && pattern < bestPattern) {
+			bestPattern, bestDistance = pattern, distance
+		}
+	}
+	if bestDistance == math.MaxInt {
+		return "", false
+	}
+
+	return adjustDatePattern(bestPattern, requested), true
+}
+
+// adjustDatePattern adjusts the field lengths of a pattern to the requested fields, keeping numeric fields
+// numeric and text fields text. Hour, minute and second fields keep the length of the pattern.
+func adjustDatePattern(pattern string, requested map[rune]dateField) string {
+	var builder strings.Builder
+	walkDatePattern(pattern, func(literal string) {
+		builder.WriteString(quoteDateLiteral(literal))
+	}, func(field dateField) {
+		fieldType := dateFieldTypes[field.letter]
+		if want, ok := requested[fieldType]; ok && !strings.ContainsRune("hms", fieldType) && (field.count >= 3) == (want.count >= 3) {
+			field.count = want.count
+		}
+		builder.WriteString(strings.Repeat(string(field.letter), field.count))
+	})
+
+	return builder.String()
+}
+
+// parseDateSkeleton parses a skeleton into its fields. The hour letters j, J and C are replaced with the
+// preferred hour letter of the locale, derived from its short time format.
+func parseDateSkeleton(skeleton string, calendarFormat *models.CalendarFormat) []dateField {
+	preferredHour := 'H'
+	if strings.ContainsAny(calendarFormat.TimeFormats["short"], "hK") {
+		preferredHour = 'h'
+	}
+
+	fields := make([]dateField, 0)
+	walkDatePattern(skeleton, func(string) {}, func(field dateField) {
+		if _, ok := dateFieldTypes[field.letter]; !ok {
+			return
+		}
+		if field.letter == 'j' || field.letter == 'J' || field.letter == 'C' {
+			field.letter = preferredHour
+		}
+		fields = append(fields, field)
+	})
+
+	return fields
+}
+
+// walkDatePattern calls literal for every literal text and field for every field of a pattern.
+func walkDatePattern(pattern string, literal func(string), field func(dateField)) {
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r == '\'':
+			// Quoted text; two single quotes are a literal single quote.
+			if i+1 < len(runes) && runes[i+1] == '\'' {
+				literal("'")
+				i += 2
+				continue
+			}
+			var text strings.Builder
+			i++
+			for i < len(runes) {
+				if runes[i] == '\'' {
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						text.WriteRune('\'')
+						i += 2
+						continue
+					}
+					break
+				}
+				text.WriteRune(runes[i])
+				i++
+			}
+			i++
+			literal(text.String())
+		case (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z'):
+			count := 1
+			for i+count < len(runes) && runes[i+count] == r {
+				count++
+			}
+			field(dateField{letter: r, count: count})
+			i += count
+		default:
+			literal(string(r))
+			i++
+		}
+	}
+}
+
+// quoteDateLiteral quotes literal text that contains letters or quotes.
+func quoteDateLiteral(literal string) string {
+	if !strings.ContainsFunc(literal, func(r rune) bool {
+		return r == '\'' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+	}) {
+		return literal
+	}
+
+	return "'" + strings.ReplaceAll(literal, "'", "''") + "'"
+}
+
+// formatDatePattern formats a timestamp with a CLDR date pattern.
+// Flexible day periods (B) are formatted as AM/PM. Time zone names are formatted in the localized GMT format.
+func formatDatePattern(pattern string, t time.Time, calendarFormat *models.CalendarFormat, digits string) string {
+	var builder strings.Builder
+
+	number := func(value, count int) string {
+		return nativeDigits(fmt.Sprintf("%0*d", count, value), digits)
+	}
+	name := func(names models.CalendarNames, context string, count int, key string) string {
+		width := "abbreviated"
+		switch count {
+		case 4:
+			width = "wide"
+		case 5:
+			width = "narrow"
+		case 6:
+			width = "short"
+		}
+		for _, c := range []string{context, "format", "stand-alone"} {
+			for _, w := range []string{width, "abbreviated", "wide"} {
+				if value, ok := names[c][w][key]; ok {
+					return value
+				}
+			}
+		}
+		return key
+	}
+
+	walkDatePattern(pattern, func(literal string) {
+		builder.WriteString(literal)
+	}, func(field dateField) {
+		switch field.letter {
+		case 'G':
+			era, width := "1", "eraAbbr"
+			if t.Year() <= 0 {
+				era = "0"
+			}
+			if field.count == 4 {
+				width = "eraNames"
+			} else if field.count == 5 {
+				width = "eraNarrow"
+			}
+			builder.WriteString(calendarFormat.Eras[width][era])
+		case 'y', 'u', 'Y':
+			year := t.Year()
+			if field.letter == 'Y' {
+				year, _ = t.ISOWeek()
+			}
+			if field.count == 2 {
+				builder.WriteString(number(year%100, 2))
+			} else {
+				builder.WriteString(number(year, field.count))
+			}
+		case 'Q', 'q':
+			quarter := (int(t.Month())-1)/3 + 1
+			if field.count <= 2 {
+				builder.WriteString(number(quarter, field.count))
+			} else {
+				builder.WriteString(name(calendarFormat.Quarters, dateNameContext(field.letter == 'q'), field.count, strconv.Itoa(quarter)))
+			}
+		case 'M', 'L':
+			if field.count <= 2 {
+				builder.WriteString(number(int(t.Month()), field.count))
+			} else {
+				builder.WriteString(name(calendarFormat.Months, dateNameContext(field.letter == 'L'), field.count, strconv.Itoa(int(t.Month()))))
+			}
+		case 'w':
+			_, week := t.ISOWeek()
+			builder.WriteString(number(week, field.count))
+		case 'W':
+			firstOfMonth := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+			builder.WriteString(number((t.Day()-1+int(firstOfMonth.Weekday()))/7+1, field.count))
+		case 'd':
+			builder.WriteString(number(t.Day(), field.count))
+		case 'D':
+			builder.WriteString(number(t.YearDay(), field.count))
+		case 'F':
+			builder.WriteString(number((t.Day()-1)/7+1, field.count))
+		case 'E', 'e', 'c':
+			if field.letter != 'E' && field.count <= 2 {
+				// Numeric day of the week, starting at Monday.
+				builder.WriteString(number((int(t.Weekday())+6)%7+1, field.count))
+			} else {
+				count := field.count
+				if count < 3 {
+					count = 3
+				}
+				builder.WriteString(name(calendarFormat.Days, dateNameContext(field.letter == 'c'), count, dateWeekdayKeys[t.Weekday()]))
+			}
+		case 'a', 'b', 'B':
+			key := "am"
+			if t.Hour() >= 12 {
+				key = "pm"
+			}
+			if field.letter == 'b' && t.Minute() == 0 && (t.Hour() == 12 || t.Hour() == 0) {
+				if t.Hour() == 12 {
+					key = "noon"
+				} else {
+					key = "midnight"
+				}
+				if _, ok := calendarFormat.DayPeriods["format"]["abbreviated"][key]; !ok {
+					key = map[bool]string{true: "pm", false: "am"}[t.Hour() == 12]
+				}
+			}
+			builder.WriteString(name(calendarFormat.DayPeriods, "format", field.count, key))
+		case 'h':
+			builder.WriteString(number((t.Hour()+11)%12+1, field.count))
+		case 'H':
+			builder.WriteString(number(t.Hour(), field.count))
+		case 'K':
+			builder.WriteString(number(t.Hour()%12, field.count))
+		case 'k':
+			builder.WriteString(number((t.Hour()+23)%24+1, field.count))
+		case 'm':
+			builder.WriteString(number(t.Minute(), field.count))
+		case 's':
+			builder.WriteString(number(t.Second(), field.count))
+		case 'S':
+			fraction := fmt.Sprintf("%09d", t.Nanosecond())
+			for len(fraction) < field.count {
+				fraction += "0"
+			}
+			builder.WriteString(nativeDigits(fraction[:field.count], digits))
+		case 'A':
+			builder.WriteString(number(((t.Hour()*60+t.Minute())*60+t.Second())*1000+t.Nanosecond()/1e6, field.count))
+		case 'z', 'Z', 'O', 'v', 'V', 'X', 'x':
+			builder.WriteString(formatDateZone(field, t, calendarFormat, digits))
+		default:
+			builder.WriteString(strings.Repeat(string(field.letter), field.count))
+		}
+	})
+
+	return builder.String()
+}
+
+// formatDateZone formats the time zone fields of a pattern.
+func formatDateZone(field dateField, t time.Time, calendarFormat *models.CalendarFormat, digits string) string {
+	abbreviation, offset := t.Zone()
+	sign := "+"
+	if offset < 0 {
+		sign, offset = "-", -offset
+	}
+	hours, minutes, seconds := offset/3600, offset/60%60, offset%60
+
+	iso := func(extended, optionalMinutes, withSeconds bool) string {
+		if offset == 0 && field.letter == 'X' {
+			return "Z"
+		}
+		separator := ""
+		if extended {
+			separator = ":"
+		}
+		value := fmt.Sprintf("%s%02d", sign, hours)
+		if !optionalMinutes || minutes != 0 {
+			value += fmt.Sprintf("%s%02d", separator, minutes)
+		}
+		if withSeconds && seconds != 0 {
+			value += fmt.Sprintf("%s%02d", separator, seconds)
+		}
+		return value
+	}
+	gmt := func(long bool) string {
+		_, offset := t.Zone()
+		return localizedGMT(calendarFormat, offset, long, digits)
+	}
+
+	switch field.letter {
+	case 'z':
+		if field.count <= 3 && abbreviation != "" && !strings.ContainsAny(abbreviation, "+-") {
+			return abbreviation
+		}
+		return gmt(field.count == 4)
+	case 'Z':
+		switch {
+		case field.count <= 3:
+			return iso(false, false, false)
+		case field.count == 4:
+			return gmt(true)
+		default:
+			if offset == 0 {
+				return "Z"
+			}
+			return iso(true, false, true)
+		}
+	case 'O':
+		return gmt(field.count == 4)
+	case 'v':
+		return gmt(field.count == 4)
+	case 'V':
+		switch field.count {
+		case 1:
+			return "unk"
+		case 2:
+			return t.Location().String()
+		case 3:
+			name := t.Location().String()
+			return strings.ReplaceAll(name[strings.LastIndex(name, "/")+1:], "_", " ")
+		default:
+			return gmt(true)
+		}
+	default:
+		switch field.count {
+		case 1:
+			return iso(false, true, false)
+		case 2:
+			return iso(false, false, false)
+		case 3:
+			return iso(true, false, false)
+		case 4:
+			return iso(false, false, true)
+		default:
+			return iso(true, false, true)
+		}
+	}
+}
+
+// localizedGMT formats a UTC offset in seconds in the localized GMT format of a locale, e.g. "GMT+1" or "UTC+01:00".
+// The long format follows the hour format of the locale; the short format has no leading zero and leaves out zero minutes.
+// Seconds are only added when the offset has them.
+func localizedGMT(calendarFormat *models.CalendarFormat, offset int, long bool, digits string) string {
+	gmtFormat, gmtZeroFormat, hourFormat := calendarFormat.GMTFormat, calendarFormat.GMTZeroFormat, calendarFormat.HourFormat
+	if !strings.Contains(gmtFormat, "{0}") {
+		gmtFormat = models.DefaultGMTFormat
+	}
+	if gmtZeroFormat == "" {
+		gmtZeroFormat = models.DefaultGMTZeroFormat
+	}
+	if offset == 0 {
+		return gmtZeroFormat
+	}
+
+	positive, negative, ok := strings.Cut(hourFormat, ";")
+	if !ok || !strings.Contains(positive, "mm") || !strings.Contains(negative, "mm") {
+		positive, negative, _ = strings.Cut(models.DefaultHourFormat, ";")
+	}
+	pattern := positive
+	if offset < 0 {
+		pattern, offset = negative, -offset
+	}
+	hours, minutes, seconds := offset/3600, offset/60%60, offset%60
+
+	if !long {
+		pattern = strings.Replace(pattern, "HH", "H", 1)
+	}
+	hourEnd := strings.LastIndex(pattern, "H") + 1
+	minuteStart := strings.Index(pattern, "mm")
+	if minuteStart >= hourEnd {
+		if !long && minutes == 0 && seconds == 0 {
+			pattern = pattern[:hourEnd] + pattern[minuteStart+2:]
+		} else if seconds != 0 {
+			pattern = pattern[:minuteStart+2] + pattern[hourEnd:minuteStart] + "ss" + pattern[minuteStart+2:]
+		}
+	}
+
+	value := strings.NewReplacer(
+		"HH", fmt.Sprintf("%02d", hours),
+		"H", strconv.Itoa(hours),
+		"mm", fmt.Sprintf("%02d", minutes),
+		"ss", fmt.Sprintf("%02d", seconds),
+	).Replace(pattern)
+
+	return strings.Replace(gmtFormat, "{0}", nativeDigits(value, digits), 1)
+}
+
+// dateNameContext returns the name context of a stand-alone or format field.
+func dateNameContext(standAlone bool) string {
+	if standAlone {
+		return "stand-alone"
+	}
+
+	return "format"
+}
+
+// abs returns the absolute value of an integer.
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+
+	return value
+}
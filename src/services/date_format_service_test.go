@@ -0,0 +1,47 @@
+package services
+
+import (
+	"api-i18n/main/src/models"
+	"testing"
+)
+
+func TestLocalizedGMT(t *testing.T) {
+	root := &models.CalendarFormat{GMTFormat: "GMT{0}", GMTZeroFormat: "GMT", HourFormat: "+HH:mm;-HH:mm"}
+	french := &models.CalendarFormat{GMTFormat: "UTC{0}", GMTZeroFormat: "UTC", HourFormat: "+HH:mm;−HH:mm"}
+	finnish := &models.CalendarFormat{GMTFormat: "UTC{0}", GMTZeroFormat: "UTC", HourFormat: "+H.mm;-H.mm"}
+	unseeded := &models.CalendarFormat{}
+
+	tests := []struct {
+		name           string
+		calendarFormat *models.CalendarFormat
+		offset         int
+		long           bool
+		digits         string
+		want           string
+	}{
+		{name: "root zero", calendarFormat: root, offset: 0, want: "GMT"},
+		{name: "root short", calendarFormat: root, offset: 3600, want: "GMT+1"},
+		{name: "root long", calendarFormat: root, offset: 3600, long: true, want: "GMT+01:00"},
+		{name: "root short minutes", calendarFormat: root, offset: 19800, want: "GMT+5:30"},
+		{name: "root long seconds", calendarFormat: root, offset: -(3600 + 30*60 + 15), long: true, want: "GMT-01:30:15"},
+		{name: "french zero", calendarFormat: french, offset: 0, long: true, want: "UTC"},
+		{name: "french short", calendarFormat: french, offset: -5 * 3600, want: "UTC−5"},
+		{name: "french long", calendarFormat: french, offset: 2 * 3600, long: true, want: "UTC+02:00"},
+		{name: "finnish long", calendarFormat: finnish, offset: 2 * 3600, long: true, want: "UTC+2.00"},
+		{name: "finnish short minutes", calendarFormat: finnish, offset: 5*3600 + 45*60, want: "UTC+5.45"},
+		{name: "unseeded", calendarFormat: unseeded, offset: -3 * 3600, long: true, want: "GMT-03:00"},
+		{name: "native digits", calendarFormat: root, offset: 3 * 3600, digits: "٠١٢٣٤٥٦٧٨٩", want: "GMT+٣"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			digits := test.digits
+			if digits == "" {
+				digits = "0123456789"
+			}
+			if got := localizedGMT(test.calendarFormat, test.offset, test.long, digits); got != test.want {
+				t.Errorf("localizedGMT(%d, %v) = %q, want %q", test.offset, test.long, got, test.want)
+			}
+		})
+	}
+}
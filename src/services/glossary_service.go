@@ -0,0 +1,310 @@
+package services
+
+import (
+	"api-i18n/main/src/database"
+	"api-i18n/main/src/dto/requests"
+	"api-i18n/main/src/dto/responses"
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+	"database/sql"
+	"regexp"
+
+	"github.com/ArnoldPMolenaar/api-utils/pagination"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// IsGlossaryTermAvailable method to check if a glossary term is available for an app and source locale.
+func IsGlossaryTermAvailable(appName, localeID, term string, ignore *uint) (bool, error) {
+	query := database.Pg.Limit(1)
+	var result *gorm.DB
+	if ignore != nil {
+		result = query.Find(&models.GlossaryTerm{}, "app_name = ? AND locale_id = ? AND term = ? AND id != ?", appName, localeID, term, *ignore)
+	} else {
+		result = query.Find(&models.GlossaryTerm{}, "app_name = ? AND locale_id = ? AND term = ?", appName, localeID, term)
+	}
+
+	if result.Error != nil {
+		return false, result.Error
+	} else {
+		return result.RowsAffected == 0, nil
+	}
+}
+
+// GetGlossaryTerms method to get paginated glossary terms.
+func GetGlossaryTerms(c *fiber.Ctx) (*pagination.Model, error) {
+	terms := make([]models.GlossaryTerm, 0)
+	values := c.Request().URI().QueryArgs()
+	allowedColumns := map[string]bool{
+		"id":               true,
+		"app_name":         true,
+		"locale_id":        true,
+		"term":             true,
+		"do_not_translate": true,
+		"case_sensitive":   true,
+		"created_at":       true,
+		"updated_at":       true,
+	}
+
+	queryFunc := pagination.Query(values, allowedColumns)
+	sortFunc := pagination.Sort(values, allowedColumns)
+	page := c.QueryInt("page", 1)
+	if page < 1 {
+		page = 1
+	}
+	limit := c.QueryInt("limit", 10)
+	if limit < 1 {
+		limit = 10
+	}
+	offset := pagination.Offset(page, limit)
+	dbResult := database.Pg.Scopes(queryFunc, sortFunc).
+		Preload("Translations").
+		Limit(limit).
+		Offset(offset)
+
+	total := int64(0)
+	dbCount := database.Pg.Scopes(queryFunc).
+		Model(&models.GlossaryTerm{})
+
+	if result := dbResult.Find(&terms); result.Error != nil {
+		return nil, result.Error
+	}
+
+	dbCount.Count(&total)
+	pageCount := pagination.Count(int(total), limit)
+
+	paginatedTerms := make([]responses.PaginatedGlossaryTerm, 0)
+	for i := range terms {
+		paginatedTerm := responses.PaginatedGlossaryTerm{}
+		paginatedTerm.SetPaginatedGlossaryTerm(&terms[i])
+		paginatedTerms = append(paginatedTerms, paginatedTerm)
+	}
+
+	paginationModel := pagination.CreatePaginationModel(limit, page, pageCount, int(total), paginatedTerms)
+
+	return &paginationModel, nil
+}
+
+// GetGlossaryTermsByApp method to get all glossary terms of an app with their translations.
+func GetGlossaryTermsByApp(appName string) ([]models.GlossaryTerm, error) {
+	terms := make([]models.GlossaryTerm, 0)
+
+	if result := database.Pg.
+		Preload("Translations").
+		Order("id").
+		Find(&terms, "app_name = ?", appName); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return terms, nil
+}
+
+// GetGlossaryTermByID method to get a glossary term by ID.
+func GetGlossaryTermByID(termID uint) (*models.GlossaryTerm, error) {
+	term := &models.GlossaryTerm{}
+
+	if result := database.Pg.Preload("Translations").Find(term, "id = ?", termID); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return term, nil
+}
+
+// CreateGlossaryTerm method to create a glossary term.
+func CreateGlossaryTerm(termDto requests.CreateGlossaryTerm) (*models.GlossaryTerm, error) {
+	term := &models.GlossaryTerm{
+		AppName:        termDto.AppName,
+		LocaleID:       termDto.LocaleID,
+		Term:           termDto.Term,
+		DoNotTranslate: termDto.DoNotTranslate,
+		CaseSensitive:  termDto.CaseSensitive,
+	}
+	if termDto.Description != nil {
+		term.Description = sql.NullString{String: *termDto.Description, Valid: true}
+	}
+
+	term.Translations = make([]models.GlossaryTermTranslation, len(termDto.Translations))
+	for i, translation := range termDto.Translations {
+		term.Translations[i] = models.GlossaryTermTranslation{
+			LocaleID: translation.LocaleID,
+			Value:    translation.Value,
+		}
+	}
+
+	if err := database.Pg.Create(&term).Error; err != nil {
+		return nil, err
+	}
+
+	return term, nil
+}
+
+// UpdateGlossaryTerm method to update a glossary term.
+// Translations that are not part of the DTO are removed.
+func UpdateGlossaryTerm(oldTerm models.GlossaryTerm, termDto requests.UpdateGlossaryTerm) (*models.GlossaryTerm, error) {
+	oldTerm.LocaleID = termDto.LocaleID
+	oldTerm.Term = termDto.Term
+	oldTerm.DoNotTranslate = termDto.DoNotTranslate
+	oldTerm.CaseSensitive = termDto.CaseSensitive
+	if termDto.Description != nil {
+		oldTerm.Description = sql.NullString{String: *termDto.Description, Valid: true}
+	} else {
+		oldTerm.Description = sql.NullString{Valid: false}
+	}
+
+	translations := make([]models.GlossaryTermTranslation, len(termDto.Translations))
+	for i, translation := range termDto.Translations {
+		translations[i] = models.GlossaryTermTranslation{
+			GlossaryTermID: oldTerm.ID,
+			LocaleID:       translation.LocaleID,
+			Value:          translation.Value,
+		}
+	}
+	oldTerm.Translations = nil
+
+	err := database.Pg.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Translations").Save(&oldTerm).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("glossary_term_id = ?", oldTerm.ID).Delete(&models.GlossaryTermTranslation{}).Error; err != nil {
+			return err
+		}
+
+		if len(translations) > 0 {
+			if err := tx.Create(&translations).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	oldTerm.Translations = translations
+
+	return &oldTerm, nil
+}
+
+// DeleteGlossaryTerm method to delete a glossary term with its translations.
+// The term is deleted permanently, so it can be created again under the unique term index.
+func DeleteGlossaryTerm(termID uint) error {
+	return database.Pg.Unscoped().Delete(&models.GlossaryTerm{Model: gorm.Model{ID: termID}}).Error
+}
+
+// GetGlossaryCompliance method to check all keys of an app against its glossary.
+// Returns the number of checked keys and the found violations.
+func GetGlossaryCompliance(appName string) (int, []responses.GlossaryViolation, error) {
+	keys := make([]models.Key, 0)
+
+	if result := database.Pg.
+		Scopes(scopeExcludeDeletedCategory).
+		Preload("Translations").
+		Order("keys.id").
+		Find(&keys, "keys.app_name = ?", appName); result.Error != nil {
+		return 0, nil, result.Error
+	}
+
+	violations, err := CheckGlossaryCompliance(appName, keys)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return len(keys), violations, nil
+}
+
+// CheckGlossaryCompliance method to check the translations of the given keys against the glossary of an app.
+// A glossary term applies to a key when the key's translation in the term's source locale contains the term.
+// Do-not-translate terms must then appear unchanged in every other locale, other terms must appear with
+// their approved translation in every locale that has one.
+func CheckGlossaryCompliance(appName string, keys []models.Key) ([]responses.GlossaryViolation, error) {
+	violations := make([]responses.GlossaryViolation, 0)
+
+	terms, err := GetGlossaryTermsByApp(appName)
+	if err != nil {
+		return nil, err
+	} else if len(terms) == 0 {
+		return violations, nil
+	}
+
+	matchers := make(map[string]*regexp.Regexp)
+	contains := func(text, term string, caseSensitive bool) bool {
+		pattern := glossaryTermPattern(term, caseSensitive)
+		matcher, ok := matchers[pattern]
+		if !ok {
+			matcher = regexp.MustCompile(pattern)
+			matchers[pattern] = matcher
+		}
+
+		return matcher.MatchString(text)
+	}
+
+	for i := range keys {
+		key := &keys[i]
+
+		sources := make(map[string]string)
+		for j := range key.Translations {
+			sources[key.Translations[j].LocaleID] = key.Translations[j].Value
+		}
+
+		for j := range terms {
+			term := &terms[j]
+
+			source, ok := sources[term.LocaleID]
+			if !ok || !contains(source, term.Term, term.CaseSensitive) {
+				continue
+			}
+
+			approved := make(map[string]string)
+			for k := range term.Translations {
+				approved[term.Translations[k].LocaleID] = term.Translations[k].Value
+			}
+
+			for k := range key.Translations {
+				translation := &key.Translations[k]
+				if translation.LocaleID == term.LocaleID {
+					continue
+				}
+
+				var expected string
+				var violationType enums.GlossaryViolationType
+				if term.DoNotTranslate {
+					expected = term.Term
+					violationType = enums.DO_NOT_TRANSLATE
+				} else if value, exists := approved[translation.LocaleID]; exists {
+					expected = value
+					violationType = enums.APPROVED_TRANSLATION
+				} else {
+					continue
+				}
+
+				if contains(translation.Value, expected, term.CaseSensitive) {
+					continue
+				}
+
+				violations = append(violations, responses.GlossaryViolation{
+					KeyID:          key.ID,
+					KeyName:        key.Name,
+					LocaleID:       translation.LocaleID,
+					GlossaryTermID: term.ID,
+					Term:           term.Term,
+					Expected:       expected,
+					Type:           violationType.String(),
+				})
+			}
+		}
+	}
+
+	return violations, nil
+}
+
+// glossaryTermPattern builds a regular expression that matches a term as a whole word.
+func glossaryTermPattern(term string, caseSensitive bool) string {
+	pattern := `(?:^|[^\p{L}\p{N}_])` + regexp.QuoteMeta(term) + `(?:$|[^\p{L}\p{N}_])`
+	if !caseSensitive {
+		pattern = "(?i)" + pattern
+	}
+
+	return pattern
+}
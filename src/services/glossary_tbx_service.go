@@ -0,0 +1,300 @@
+package services
+
+import (
+	"api-i18n/main/src/database"
+	"api-i18n/main/src/dto/responses"
+	"api-i18n/main/src/models"
+	"database/sql"
+	"encoding/xml"
+	"errors"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+const (
+	tbxDescripDefinition      = "definition"
+	tbxDescripSourceLanguage  = "x-sourceLanguage"
+	tbxTermNoteDoNotTranslate = "x-doNotTranslate"
+	tbxTermNoteCaseSensitive  = "x-caseSensitive"
+)
+
+// ErrInvalidTBX is returned when an uploaded TBX document cannot be used.
+var ErrInvalidTBX = errors.New("invalid TBX document")
+
+// tbxDocument is a TBX-Basic (ISO 30042:2008) document.
+type tbxDocument struct {
+	XMLName xml.Name   `xml:"martif"`
+	Type    string     `xml:"type,attr"`
+	Lang    string     `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+	Header  tbxHeader  `xml:"martifHeader"`
+	Entries []tbxEntry `xml:"text>body>termEntry"`
+}
+
+type tbxHeader struct {
+	SourceDesc string `xml:"fileDesc>sourceDesc>p"`
+}
+
+type tbxEntry struct {
+	ID       string       `xml:"id,attr,omitempty"`
+	Descrips []tbxDescrip `xml:"descrip"`
+	LangSets []tbxLangSet `xml:"langSet"`
+}
+
+type tbxDescrip struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type tbxLangSet struct {
+	Lang string   `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+	Tigs []tbxTig `xml:"tig"`
+}
+
+type tbxTig struct {
+	Term      string        `xml:"term"`
+	TermNotes []tbxTermNote `xml:"termNote"`
+}
+
+type tbxTermNote struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// ExportGlossaryTBX method to export the glossary of an app as a TBX-Basic document.
+// Terms are grouped by source locale; the document language is the most used source locale and every entry
+// marks its own source locale, so terms with another source locale keep it on import.
+func ExportGlossaryTBX(appName string) ([]byte, error) {
+	terms, err := GetGlossaryTermsByApp(appName)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceCount := make(map[string]int)
+	documentLang := ""
+	for i := range terms {
+		sourceCount[terms[i].LocaleID]++
+		if documentLang == "" || sourceCount[terms[i].LocaleID] > sourceCount[documentLang] {
+			documentLang = terms[i].LocaleID
+		}
+	}
+
+	document := tbxDocument{
+		Type:    "TBX-Basic",
+		Lang:    documentLang,
+		Header:  tbxHeader{SourceDesc: "Glossary of app " + appName},
+		Entries: make([]tbxEntry, len(terms)),
+	}
+
+	for i := range terms {
+		term := &terms[i]
+
+		sourceTig := tbxTig{Term: term.Term}
+		if term.DoNotTranslate {
+			sourceTig.TermNotes = append(sourceTig.TermNotes, tbxTermNote{Type: tbxTermNoteDoNotTranslate, Value: "true"})
+		}
+		if term.CaseSensitive {
+			sourceTig.TermNotes = append(sourceTig.TermNotes, tbxTermNote{Type: tbxTermNoteCaseSensitive, Value: "true"})
+		}
+
+		entry := tbxEntry{
+			ID:       "term-" + strconv.FormatUint(uint64(term.ID), 10),
+			LangSets: []tbxLangSet{{Lang: term.LocaleID, Tigs: []tbxTig{sourceTig}}},
+		}
+		entry.Descrips = []tbxDescrip{{Type: tbxDescripSourceLanguage, Value: term.LocaleID}}
+		if term.Description.Valid {
+			entry.Descrips = append(entry.Descrips, tbxDescrip{Type: tbxDescripDefinition, Value: term.Description.String})
+		}
+
+		for j := range term.Translations {
+			entry.LangSets = append(entry.LangSets, tbxLangSet{
+				Lang: term.Translations[j].LocaleID,
+				Tigs: []tbxTig{{Term: term.Translations[j].Value}},
+			})
+		}
+
+		document.Entries[i] = entry
+	}
+
+	value, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), value...), nil
+}
+
+// ImportGlossaryTBX method to import a TBX-Basic document into the glossary of an app.
+// The langSet in the source language of an entry, or else the document language, is used as source term,
+// other langSets become translations.
+// Existing terms (same app, source locale and term) are updated, unknown locales are skipped.
+func ImportGlossaryTBX(appName string, data []byte) (*responses.GlossaryImport, error) {
+	appLocales, err := GetAppLocales(appName)
+	if err != nil {
+		return nil, err
+	}
+	localeIDs := make([]string, len(appLocales))
+	for i := range appLocales {
+		localeIDs[i] = appLocales[i].ID
+	}
+
+	terms, skipped, err := parseGlossaryTBX(data, localeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &responses.GlossaryImport{Skipped: skipped}
+
+	err = database.Pg.Transaction(func(tx *gorm.DB) error {
+		for i := range terms {
+			term := models.GlossaryTerm{}
+			if find := tx.Limit(1).Find(&term, "app_name = ? AND locale_id = ? AND term = ?", appName, terms[i].LocaleID, terms[i].Term); find.Error != nil {
+				return find.Error
+			}
+			isNew := term.ID == 0
+
+			term.AppName = appName
+			term.LocaleID = terms[i].LocaleID
+			term.Term = terms[i].Term
+			term.DoNotTranslate = terms[i].DoNotTranslate
+			term.CaseSensitive = terms[i].CaseSensitive
+			if terms[i].Description.Valid {
+				term.Description = terms[i].Description
+			}
+
+			if err := tx.Omit("Translations").Save(&term).Error; err != nil {
+				return err
+			}
+
+			if err := tx.Where("glossary_term_id = ?", term.ID).Delete(&models.GlossaryTermTranslation{}).Error; err != nil {
+				return err
+			}
+
+			for _, translation := range terms[i].Translations {
+				translation.GlossaryTermID = term.ID
+				if err := tx.Save(&translation).Error; err != nil {
+					return err
+				}
+			}
+
+			if isNew {
+				result.Created++
+			} else {
+				result.Updated++
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// parseGlossaryTBX parses a TBX-Basic document into glossary terms with their translations. The languages of
+// the langSets are matched case-insensitively with the given locale IDs and stored with their ID, e.g. pt-br as pt-BR.
+// Entries without source term in one of the locales are skipped and counted; other unknown languages are left out.
+func parseGlossaryTBX(data []byte, localeIDs []string) ([]models.GlossaryTerm, int, error) {
+	document := tbxDocument{}
+	if err := xml.Unmarshal(data, &document); err != nil {
+		return nil, 0, errors.Join(ErrInvalidTBX, err)
+	}
+
+	terms := make([]models.GlossaryTerm, 0, len(document.Entries))
+	skipped := 0
+
+	for i := range document.Entries {
+		entry := &document.Entries[i]
+
+		source := entry.sourceLangSet(document.Lang)
+		if source == nil || len(source.Tigs) == 0 || strings.TrimSpace(source.Tigs[0].Term) == "" {
+			skipped++
+			continue
+		}
+		sourceLocaleID, ok := tbxLocaleID(source.Lang, localeIDs)
+		if !ok {
+			skipped++
+			continue
+		}
+
+		term := models.GlossaryTerm{
+			LocaleID:     sourceLocaleID,
+			Term:         strings.TrimSpace(source.Tigs[0].Term),
+			Translations: make([]models.GlossaryTermTranslation, 0),
+		}
+		for _, note := range source.Tigs[0].TermNotes {
+			switch note.Type {
+			case tbxTermNoteDoNotTranslate:
+				term.DoNotTranslate = strings.TrimSpace(note.Value) == "true"
+			case tbxTermNoteCaseSensitive:
+				term.CaseSensitive = strings.TrimSpace(note.Value) == "true"
+			}
+		}
+		for _, descrip := range entry.Descrips {
+			if descrip.Type == tbxDescripDefinition && strings.TrimSpace(descrip.Value) != "" {
+				term.Description = sql.NullString{String: strings.TrimSpace(descrip.Value), Valid: true}
+			}
+		}
+
+		for j := range entry.LangSets {
+			langSet := &entry.LangSets[j]
+			if langSet == source || len(langSet.Tigs) == 0 || strings.TrimSpace(langSet.Tigs[0].Term) == "" {
+				continue
+			}
+			localeID, ok := tbxLocaleID(langSet.Lang, localeIDs)
+			if !ok || localeID == sourceLocaleID {
+				continue
+			}
+
+			term.Translations = append(term.Translations, models.GlossaryTermTranslation{
+				LocaleID: localeID,
+				Value:    strings.TrimSpace(langSet.Tigs[0].Term),
+			})
+		}
+
+		terms = append(terms, term)
+	}
+
+	return terms, skipped, nil
+}
+
+// tbxLocaleID returns the locale ID that matches a TBX language case-insensitively, with - or _ separators.
+func tbxLocaleID(lang string, localeIDs []string) (string, bool) {
+	lang = strings.ReplaceAll(strings.TrimSpace(lang), "_", "-")
+	for _, localeID := range localeIDs {
+		if strings.EqualFold(localeID, lang) {
+			return localeID, true
+		}
+	}
+
+	return "", false
+}
+
+// sourceLangSet returns the langSet in the source language of the entry, else the langSet in the document language,
+// or the first langSet as fallback.
+func (e *tbxEntry) sourceLangSet(documentLang string) *tbxLangSet {
+	if len(e.LangSets) == 0 {
+		return nil
+	}
+
+	sourceLangs := []string{documentLang}
+	for _, descrip := range e.Descrips {
+		if descrip.Type == tbxDescripSourceLanguage && strings.TrimSpace(descrip.Value) != "" {
+			sourceLangs = []string{strings.TrimSpace(descrip.Value), documentLang}
+		}
+	}
+
+	for _, sourceLang := range sourceLangs {
+		sourceLang = strings.ReplaceAll(sourceLang, "_", "-")
+		for i := range e.LangSets {
+			if strings.EqualFold(strings.ReplaceAll(e.LangSets[i].Lang, "_", "-"), sourceLang) {
+				return &e.LangSets[i]
+			}
+		}
+	}
+
+	return &e.LangSets[0]
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseGlossaryTBX(t *testing.T) {
+	const document = `<?xml version="1.0" encoding="UTF-8"?>
+<martif type="TBX-Basic" xml:lang="EN-us">
+  <martifHeader><fileDesc><sourceDesc><p>Glossary of app shop</p></sourceDesc></fileDesc></martifHeader>
+  <text>
+    <body>
+      <termEntry id="term-1">
+        <descrip type="definition">The last step of an order.</descrip>
+        <langSet xml:lang="en-US">
+          <tig><term> Checkout </term><termNote type="x-caseSensitive">true</termNote></tig>
+        </langSet>
+        <langSet xml:lang="nl_nl"><tig><term>Afrekenen</term></tig></langSet>
+        <langSet xml:lang="fr"><tig><term>Paiement</term></tig></langSet>
+        <langSet xml:lang="DE"><tig><term> </term></tig></langSet>
+      </termEntry>
+      <termEntry id="term-2">
+        <langSet xml:lang="nl-NL">
+          <tig><term>Shop</term><termNote type="x-doNotTranslate">true</termNote></tig>
+        </langSet>
+      </termEntry>
+      <termEntry id="term-3">
+        <langSet xml:lang="fr"><tig><term>Panier</term></tig></langSet>
+      </termEntry>
+      <termEntry id="term-5">
+        <descrip type="x-sourceLanguage">nl-NL</descrip>
+        <langSet xml:lang="en-US"><tig><term>Basket</term></tig></langSet>
+        <langSet xml:lang="nl-NL"><tig><term>Winkelmand</term></tig></langSet>
+      </termEntry>
+      <termEntry id="term-4">
+        <langSet xml:lang="en-us"><tig><term></term></tig></langSet>
+      </termEntry>
+    </body>
+  </text>
+</martif>`
+
+	terms, skipped, err := parseGlossaryTBX([]byte(document), []string{"en-US", "nl-NL", "de"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if skipped != 2 {
+		t.Errorf("skipped = %d, want 2", skipped)
+	}
+	if len(terms) != 3 {
+		t.Fatalf("len(terms) = %d, want 3", len(terms))
+	}
+
+	checkout := terms[0]
+	if checkout.LocaleID != "en-US" || checkout.Term != "Checkout" || !checkout.CaseSensitive || checkout.DoNotTranslate {
+		t.Errorf("terms[0] = %s %q caseSensitive=%v doNotTranslate=%v, want en-US \"Checkout\" caseSensitive=true doNotTranslate=false",
+			checkout.LocaleID, checkout.Term, checkout.CaseSensitive, checkout.DoNotTranslate)
+	}
+	if checkout.Description.String != "The last step of an order." {
+		t.Errorf("terms[0].Description = %q", checkout.Description.String)
+	}
+	if len(checkout.Translations) != 1 || checkout.Translations[0].LocaleID != "nl-NL" || checkout.Translations[0].Value != "Afrekenen" {
+		t.Errorf("terms[0].Translations = %+v, want only nl-NL Afrekenen", checkout.Translations)
+	}
+
+	// Without langSet in the document language, the first langSet is the source.
+	shop := terms[1]
+	if shop.LocaleID != "nl-NL" || shop.Term != "Shop" || !shop.DoNotTranslate || shop.Description.Valid {
+		t.Errorf("terms[1] = %s %q doNotTranslate=%v, want nl-NL \"Shop\" doNotTranslate=true", shop.LocaleID, shop.Term, shop.DoNotTranslate)
+	}
+
+	// The source language of an entry wins over the document language.
+	basket := terms[2]
+	if basket.LocaleID != "nl-NL" || basket.Term != "Winkelmand" || len(basket.Translations) != 1 || basket.Translations[0].Value != "Basket" {
+		t.Errorf("terms[2] = %s %q %+v, want nl-NL \"Winkelmand\" with en-US Basket", basket.LocaleID, basket.Term, basket.Translations)
+	}
+}
+
+func TestParseGlossaryTBXInvalid(t *testing.T) {
+	if _, _, err := parseGlossaryTBX([]byte("<martif><text>"), []string{"en"}); !errors.Is(err, ErrInvalidTBX) {
+		t.Errorf("parseGlossaryTBX error = %v, want %v", err, ErrInvalidTBX)
+	}
+}
+
+func TestTBXLocaleID(t *testing.T) {
+	localeIDs := []string{"en", "pt-BR", "zh-Hant-TW"}
+
+	tests := []struct {
+		lang string
+		want string
+		ok   bool
+	}{
+		{lang: "en", want: "en", ok: true},
+		{lang: "EN", want: "en", ok: true},
+		{lang: "pt-br", want: "pt-BR", ok: true},
+		{lang: "pt_BR", want: "pt-BR", ok: true},
+		{lang: " zh-hant-tw ", want: "zh-Hant-TW", ok: true},
+		{lang: "pt", ok: false},
+		{lang: "", ok: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.lang, func(t *testing.T) {
+			got, ok := tbxLocaleID(test.lang, localeIDs)
+			if got != test.want || ok != test.ok {
+				t.Errorf("tbxLocaleID(%q) = %q, %v, want %q, %v", test.lang, got, ok, test.want, test.ok)
+			}
+		})
+	}
+}
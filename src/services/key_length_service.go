@@ -0,0 +1,260 @@
+package services
+
+import (
+	"api-i18n/main/src/database"
+	"api-i18n/main/src/dto/requests"
+	"api-i18n/main/src/dto/responses"
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+	"database/sql"
+	"encoding/json"
+	"html"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+	"gorm.io/gorm"
+)
+
+var (
+	// lengthHTMLBreak matches HTML line breaks.
+	lengthHTMLBreak = regexp.MustCompile(`(?i)<br\s*/?>`)
+	// lengthHTMLTag matches HTML tags and comments.
+	lengthHTMLTag = regexp.MustCompile(`<!--.*?-->|</?[a-zA-Z][^<>]*>`)
+)
+
+// keyLength is the measured length of a translation value.
+type keyLength struct {
+	characters int
+	graphemes  int
+	lines      int
+}
+
+// GetKeyLengthViolations method to get the translations of an app that exceed the length limits of their key.
+// Returns the number of keys with length limits and the violations, optionally filtered on a locale.
+func GetKeyLengthViolations(appName string, localeID *string) (int, []responses.KeyLengthViolation, error) {
+	keys := make([]models.Key, 0)
+
+	query := database.Pg.
+		Scopes(scopeExcludeDeletedCategory).
+		Preload("LengthLimits").
+		Where("keys.app_name = ?", appName).
+		Where("keys.max_characters IS NOT NULL OR keys.max_graphemes IS NOT NULL OR keys.max_lines IS NOT NULL " +
+			"OR EXISTS (SELECT 1 FROM key_length_limits WHERE key_length_limits.key_id = keys.id)")
+	if localeID != nil {
+		query = query.Preload("Translations", "locale_id = ?", *localeID)
+	} else {
+		query = query.Preload("Translations")
+	}
+
+	if result := query.Order("keys.id").Find(&keys); result.Error != nil {
+		return 0, nil, result.Error
+	}
+
+	violations := make([]responses.KeyLengthViolation, 0)
+	for i := range keys {
+		violations = append(violations, CheckKeyLengthLimits(&keys[i], keys[i].Translations)...)
+	}
+
+	return len(keys), violations, nil
+}
+
+// CheckCreateKeyLengthLimits checks the translations of a new key against its length limits.
+func CheckCreateKeyLengthLimits(keyDto requests.CreateKey) []responses.KeyLengthViolation {
+	key := models.Key{Name: keyDto.Name}
+	setKeyLengthLimits(&key, keyDto.MaxCharacters, keyDto.MaxGraphemes, keyDto.MaxLines, keyDto.LengthLimits)
+
+	translations := make([]models.KeyTranslation, len(keyDto.Translations))
+	for i, translation := range keyDto.Translations {
+		translations[i] = models.KeyTranslation{
+			LocaleID:  translation.LocaleID,
+			ValueType: enums.ValueType(translation.ValueType),
+			Value:     translation.Value,
+		}
+	}
+
+	return CheckKeyLengthLimits(&key, translations)
+}
+
+// CheckUpdateKeyLengthLimits checks the translations of a key after the update against its new length limits,
+// including the existing translations that are not part of the update.
+func CheckUpdateKeyLengthLimits(oldKey models.Key, keyDto requests.UpdateKey) []responses.KeyLengthViolation {
+	key := models.Key{Model: oldKey.Model, Name: keyDto.Name}
+	setKeyLengthLimits(&key, keyDto.MaxCharacters, keyDto.MaxGraphemes, keyDto.MaxLines, keyDto.LengthLimits)
+
+	translations := make(map[string]models.KeyTranslation)
+	localeIDs := make([]string, 0)
+	for _, translation := range oldKey.Translations {
+		translations[translation.LocaleID] = translation
+		localeIDs = append(localeIDs, translation.LocaleID)
+	}
+	for _, translation := range keyDto.Translations {
+		if _, found := translations[translation.LocaleID]; !found {
+			localeIDs = append(localeIDs, translation.LocaleID)
+		}
+		translations[translation.LocaleID] = models.KeyTranslation{
+			LocaleID:  translation.LocaleID,
+			ValueType: enums.ValueType(translation.ValueType),
+			Value:     translation.Value,
+		}
+	}
+
+	merged := make([]models.KeyTranslation, len(localeIDs))
+	for i, localeID := range localeIDs {
+		merged[i] = translations[localeID]
+	}
+
+	return CheckKeyLengthLimits(&key, merged)
+}
+
+// CheckKeyLengthLimits checks translations against the length limits of a key.
+func CheckKeyLengthLimits(key *models.Key, translations []models.KeyTranslation) []responses.KeyLengthViolation {
+	violations := make([]responses.KeyLengthViolation, 0)
+
+	for _, translation := range translations {
+		maxCharacters, maxGraphemes, maxLines := KeyLengthLimitsFor(key, translation.LocaleID)
+		if !maxCharacters.Valid && !maxGraphemes.Valid && !maxLines.Valid {
+			continue
+		}
+
+		length := measureKeyLength(translation.ValueType, translation.Value)
+		check := func(limitType enums.LengthLimitType, limit sql.Null[int], actual int) {
+			if limit.Valid && actual > limit.V {
+				violations = append(violations, responses.KeyLengthViolation{
+					KeyID:    key.ID,
+					KeyName:  key.Name,
+					LocaleID: translation.LocaleID,
+					Type:     limitType.String(),
+					Max:      limit.V,
+					Actual:   actual,
+				})
+			}
+		}
+		check(enums.MAX_CHARACTERS, maxCharacters, length.characters)
+		check(enums.MAX_GRAPHEMES, maxGraphemes, length.graphemes)
+		check(enums.MAX_LINES, maxLines, length.lines)
+	}
+
+	return violations
+}
+
+// KeyLengthLimitsFor returns the effective length limits of a key for a locale:
+// the limits of the locale override when set, otherwise the limits of the key.
+func KeyLengthLimitsFor(key *models.Key, localeID string) (maxCharacters, maxGraphemes, maxLines sql.Null[int]) {
+	maxCharacters, maxGraphemes, maxLines = key.MaxCharacters, key.MaxGraphemes, key.MaxLines
+
+	for _, limit := range key.LengthLimits {
+		if limit.LocaleID != localeID {
+			continue
+		}
+		if limit.MaxCharacters.Valid {
+			maxCharacters = limit.MaxCharacters
+		}
+		if limit.MaxGraphemes.Valid {
+			maxGraphemes = limit.MaxGraphemes
+		}
+		if limit.MaxLines.Valid {
+			maxLines = limit.MaxLines
+		}
+	}
+
+	return
+}
+
+// setKeyLengthLimits sets the length limits and locale overrides of a key.
+func setKeyLengthLimits(key *models.Key, maxCharacters, maxGraphemes, maxLines *int, limits []requests.KeyLengthLimit) {
+	key.MaxCharacters = nullInt(maxCharacters)
+	key.MaxGraphemes = nullInt(maxGraphemes)
+	key.MaxLines = nullInt(maxLines)
+
+	key.LengthLimits = make([]models.KeyLengthLimit, 0, len(limits))
+	for _, limit := range limits {
+		key.LengthLimits = append(key.LengthLimits, models.KeyLengthLimit{
+			KeyID:         key.ID,
+			LocaleID:      limit.LocaleID,
+			MaxCharacters: nullInt(limit.MaxCharacters),
+			MaxGraphemes:  nullInt(limit.MaxGraphemes),
+			MaxLines:      nullInt(limit.MaxLines),
+		})
+	}
+}
+
+// replaceKeyLengthLimits replaces the locale overrides of a key in the database.
+func replaceKeyLengthLimits(tx *gorm.DB, key *models.Key) error {
+	if err := tx.Where("key_id = ?", key.ID).Delete(&models.KeyLengthLimit{}).Error; err != nil {
+		return err
+	}
+
+	for i := range key.LengthLimits {
+		key.LengthLimits[i].KeyID = key.ID
+	}
+	if len(key.LengthLimits) > 0 {
+		return tx.Create(&key.LengthLimits).Error
+	}
+
+	return nil
+}
+
+// measureKeyLength measures the visible length of a translation value. HTML is measured without markup,
+// JSON by its longest string.
+func measureKeyLength(valueType enums.ValueType, value string) keyLength {
+	switch valueType {
+	case enums.HTML:
+		text := lengthHTMLBreak.ReplaceAllString(value, "\n")
+		return measureText(html.UnescapeString(lengthHTMLTag.ReplaceAllString(text, "")))
+	case enums.JSON:
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+			return measureText(value)
+		}
+		return measureJSON(decoded)
+	default:
+		return measureText(value)
+	}
+}
+
+// measureJSON returns the maximum lengths over all strings of a decoded JSON value.
+func measureJSON(value interface{}) keyLength {
+	result := keyLength{}
+	merge := func(length keyLength) {
+		result.characters = max(result.characters, length.characters)
+		result.graphemes = max(result.graphemes, length.graphemes)
+		result.lines = max(result.lines, length.lines)
+	}
+
+	switch v := value.(type) {
+	case string:
+		return measureText(v)
+	case map[string]interface{}:
+		for _, item := range v {
+			merge(measureJSON(item))
+		}
+	case []interface{}:
+		for _, item := range v {
+			merge(measureJSON(item))
+		}
+	}
+
+	return result
+}
+
+// measureText measures plain text.
+func measureText(text string) keyLength {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+
+	return keyLength{
+		characters: utf8.RuneCountInString(text),
+		graphemes:  uniseg.GraphemeClusterCount(text),
+		lines:      strings.Count(text, "\n") + 1,
+	}
+}
+
+// nullInt converts an optional int to a nullable int.
+func nullInt(value *int) sql.Null[int] {
+	if value == nil {
+		return sql.Null[int]{}
+	}
+
+	return sql.Null[int]{V: *value, Valid: true}
+}
@@ -0,0 +1,194 @@
+package services
+
+import (
+	"api-i18n/main/src/database"
+	"api-i18n/main/src/dto/responses"
+	"api-i18n/main/src/models"
+	"fmt"
+	"strings"
+
+	"github.com/ArnoldPMolenaar/api-utils/pagination"
+)
+
+// KeySearchFilter holds the optional filters of a key search.
+type KeySearchFilter struct {
+	LocaleID   *string
+	CategoryID *uint
+	ValueType  *string
+}
+
+// keySearchRank is a ranked key of a search query.
+type keySearchRank struct {
+	KeyID uint
+	Rank  float64
+	Total int
+}
+
+// keySearchMatch is a matched document of a key.
+type keySearchMatch struct {
+	KeyID    uint
+	LocaleID *string
+	Field    string
+	Rank     float64
+	Snippet  string
+}
+
+// SearchKeys method to search the name, description and translation values of the keys of an app.
+// Translation values are matched with the text search configuration of their locale, names and descriptions
+// with the "simple" configuration. Keys are ranked by their best matching document.
+func SearchKeys(appName, query string, filter KeySearchFilter, page, limit int) (*pagination.Model, error) {
+	documents, args := keySearchDocuments(appName, query, filter)
+
+	ranks := make([]keySearchRank, 0)
+	if result := database.Pg.Raw(fmt.Sprintf(`
+		WITH documents AS (%s)
+		SELECT key_id, MAX(rank) AS rank, COUNT(*) OVER () AS total
+		FROM documents
+		GROUP BY key_id
+		ORDER BY rank DESC, key_id
+		LIMIT ? OFFSET ?`, documents), append(args[:len(args):len(args)], limit, pagination.Offset(page, limit))...).
+		Scan(&ranks); result.Error != nil {
+		return nil, result.Error
+	}
+
+	total := 0
+	keyIDs := make([]uint, len(ranks))
+	for i := range ranks {
+		keyIDs[i] = ranks[i].KeyID
+		total = ranks[i].Total
+	}
+
+	results := make([]responses.KeySearchResult, 0, len(ranks))
+	if len(keyIDs) > 0 {
+		matches := make([]keySearchMatch, 0)
+		if result := database.Pg.Raw(fmt.Sprintf(`
+			WITH documents AS (%s)
+			SELECT key_id, locale_id, field, rank, snippet
+			FROM documents
+			WHERE key_id IN ?
+			ORDER BY rank DESC`, documents), append(args, keyIDs)...).
+			Scan(&matches); result.Error != nil {
+			return nil, result.Error
+		}
+
+		keys := make([]models.Key, 0)
+		if result := database.Pg.Preload("Category").Find(&keys, "id IN ?", keyIDs); result.Error != nil {
+			return nil, result.Error
+		}
+
+		keysByID := make(map[uint]*models.Key, len(keys))
+		for i := range keys {
+			keysByID[keys[i].ID] = &keys[i]
+		}
+
+		for i := range ranks {
+			key, ok := keysByID[ranks[i].KeyID]
+			if !ok {
+				continue
+			}
+
+			result := responses.KeySearchResult{}
+			result.SetKeySearchResult(key, ranks[i].Rank)
+			for j := range matches {
+				if matches[j].KeyID == key.ID {
+					result.AddMatch(matches[j].Field, matches[j].LocaleID, matches[j].Snippet)
+				}
+			}
+			results = append(results, result)
+		}
+	}
+
+	paginationModel := pagination.CreatePaginationModel(limit, page, pagination.Count(total, limit), total, results)
+
+	return &paginationModel, nil
+}
+
+// keySearchDocuments builds the query of all matching documents (name, description and translation values)
+// of the keys of an app, with their rank and highlighted snippet.
+func keySearchDocuments(appName, query string, filter KeySearchFilter) (string, []interface{}) {
+	keyConditions := []string{
+		"keys.app_name = ?",
+		"keys.deleted_at IS NULL",
+		"(keys.category_id IS NULL OR categories.deleted_at IS NULL)",
+	}
+	keyArgs := []interface{}{appName}
+	if filter.CategoryID != nil {
+		keyConditions = append(keyConditions, "keys.category_id = ?")
+		keyArgs = append(keyArgs, *filter.CategoryID)
+	}
+	if filter.ValueType != nil {
+		keyConditions = append(keyConditions, "EXISTS (SELECT 1 FROM key_translations t WHERE t.key_id = keys.id AND t.deleted_at IS NULL AND t.value_type = ?)")
+		keyArgs = append(keyArgs, *filter.ValueType)
+	}
+
+	translationConditions := []string{"key_translations.deleted_at IS NULL"}
+	translationArgs := make([]interface{}, 0)
+	if filter.LocaleID != nil {
+		translationConditions = append(translationConditions, "key_translations.locale_id = ?")
+		translationArgs = append(translationArgs, *filter.LocaleID)
+	}
+	if filter.ValueType != nil {
+		translationConditions = append(translationConditions, "key_translations.value_type = ?")
+		translationArgs = append(translationArgs, *filter.ValueType)
+	}
+
+	keyWhere := strings.Join(keyConditions, " AND ")
+	translationWhere := strings.Join(translationConditions, " AND ")
+
+	// Snippets are highlighted with <mark>, so the text is HTML escaped first; html values are shown as source.
+	document := func(config, text string) string {
+		tsQuery := fmt.Sprintf("websearch_to_tsquery(%s, ?)", config)
+		return fmt.Sprintf(
+			"ts_rank(to_tsvector(%[1]s, %[2]s), %[3]s) AS rank, "+
+				"ts_headline(%[1]s, %[4]s, %[3]s, 'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=20, MinWords=5') AS snippet "+
+				"FROM keys LEFT JOIN categories ON categories.id = keys.category_id",
+			config, text, tsQuery, keySearchEscapeHTML(text),
+		)
+	}
+	match := func(config, text string) string {
+		return fmt.Sprintf("to_tsvector(%s, %s) @@ websearch_to_tsquery(%s, ?)", config, text, config)
+	}
+
+	translationConfig := database.SearchConfigExpression("key_translations.locale_id")
+	sql := fmt.Sprintf(`
+		SELECT keys.id AS key_id, NULL AS locale_id, 'name' AS field, %s
+		WHERE %s AND %s
+		UNION ALL
+		SELECT keys.id AS key_id, NULL AS locale_id, 'description' AS field, %s
+		WHERE %s AND keys.description IS NOT NULL AND %s
+		UNION ALL
+		SELECT keys.id AS key_id, key_translations.locale_id AS locale_id, 'value' AS field, %s
+		JOIN key_translations ON key_translations.key_id = keys.id
+		WHERE %s AND %s AND %s`,
+		document("'simple'", "keys.name"), keyWhere, match("'simple'", "keys.name"),
+		document("'simple'", "keys.description"), keyWhere, match("'simple'", "keys.description"),
+		document(translationConfig, "key_translations.value"),
+		keyWhere, translationWhere, match(translationConfig, "key_translations.value"),
+	)
+
+	args := make([]interface{}, 0)
+	// Name document.
+	args = append(args, query, query)
+	args = append(args, keyArgs...)
+	args = append(args, query)
+	// Description document.
+	args = append(args, query, query)
+	args = append(args, keyArgs...)
+	args = append(args, query)
+	// Translation value document.
+	args = append(args, query, query)
+	args = append(args, keyArgs...)
+	args = append(args, translationArgs...)
+	args = append(args, query)
+
+	return sql, args
+}
+
+// keySearchEscapeHTML returns a SQL expression that escapes the HTML special characters of the given text,
+// so only the <mark> tags of a snippet are markup.
+func keySearchEscapeHTML(text string) string {
+	return fmt.Sprintf(
+		`replace(replace(replace(replace(replace(%s, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;')`,
+		text,
+	)
+}
@@ -96,6 +96,7 @@ func HasValidTranslations(appName string, localeIDs []string) (bool, error) {
 }
 
 // GetKeys method to get paginated keys.
+// With the unresolvedComments query parameter only keys with unresolved comment threads are returned.
 func GetKeys(c *fiber.Ctx) (*pagination.Model, error) {
 	keys := make([]models.Key, 0)
 	values := c.Request().URI().QueryArgs()
@@ -121,13 +122,19 @@ func GetKeys(c *fiber.Ctx) (*pagination.Model, error) {
 		limit = 10
 	}
 	offset := pagination.Offset(page, limit)
-	dbResult := database.Pg.Scopes(queryFunc, sortFunc, scopeExcludeDeletedCategory).
+	scopes := []func(*gorm.DB) *gorm.DB{queryFunc, scopeExcludeDeletedCategory}
+	if c.QueryBool("unresolvedComments") {
+		scopes = append(scopes, scopeUnresolvedComments)
+	}
+
+	dbResult := database.Pg.Scopes(append(scopes, sortFunc)...).
 		Preload("Category").
+		Preload("LengthLimits").
 		Limit(limit).
 		Offset(offset)
 
 	total := int64(0)
-	dbCount := database.Pg.Scopes(queryFunc, scopeExcludeDeletedCategory).
+	dbCount := database.Pg.Scopes(scopes...).
 		Model(&models.Key{})
 
 	if result := dbResult.Find(&keys); result.Error != nil {
@@ -157,6 +164,8 @@ func GetKeyByID(keyID uint) (*models.Key, error) {
 		Scopes(scopeExcludeDeletedCategory).
 		Preload("Category").
 		Preload("Translations").
+		Preload("LengthLimits").
+		Preload("Screenshots", func(db *gorm.DB) *gorm.DB { return db.Order("key_screenshots.id") }).
 		Find(key, "keys.id = ?", keyID); result.Error != nil {
 		return nil, result.Error
 	}
@@ -176,6 +185,7 @@ func CreateKey(keyDto requests.CreateKey) (*models.Key, error) {
 	if keyDto.Description != nil {
 		key.Description = sql.NullString{String: *keyDto.Description, Valid: true}
 	}
+	setKeyLengthLimits(key, keyDto.MaxCharacters, keyDto.MaxGraphemes, keyDto.MaxLines, keyDto.LengthLimits)
 
 	key.Translations = make([]models.KeyTranslation, len(keyDto.Translations))
 	for i, translation := range keyDto.Translations {
@@ -215,6 +225,7 @@ func UpdateKey(oldKey models.Key, keyDto requests.UpdateKey) (*models.Key, error
 	} else {
 		oldKey.Description = sql.NullString{Valid: false}
 	}
+	setKeyLengthLimits(&oldKey, keyDto.MaxCharacters, keyDto.MaxGraphemes, keyDto.MaxLines, keyDto.LengthLimits)
 
 	// Update or add translations
 	existingTranslations := make(map[string]*models.KeyTranslation)
@@ -235,8 +246,15 @@ func UpdateKey(oldKey models.Key, keyDto requests.UpdateKey) (*models.Key, error
 		}
 	}
 
-	if result := database.Pg.Session(&gorm.Session{FullSaveAssociations: true}).Save(&oldKey); result.Error != nil {
-		return nil, result.Error
+	err := database.Pg.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Session(&gorm.Session{FullSaveAssociations: true}).Omit("Screenshots", "LengthLimits").Save(&oldKey).Error; err != nil {
+			return err
+		}
+
+		return replaceKeyLengthLimits(tx, &oldKey)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	for i := range oldKey.Translations {
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"api-i18n/main/src/database"
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+	"errors"
+	"strings"
+)
+
+// ErrListPatternNotFound is returned when a locale has no list pattern for a type.
+var ErrListPatternNotFound = errors.New("list pattern not found")
+
+// GetListPattern method to get the list pattern of a locale for a type and width.
+// Locales without patterns fall back to their parent, e.g. de-CH uses de; missing widths fall back to long.
+func GetListPattern(localeID string, listType enums.ListType, width enums.FormatWidth) (*models.ListPattern, error) {
+	listPatterns := make([]models.ListPattern, 0)
+	candidates := localeFallbacks(localeID)
+	widths := []enums.FormatWidth{width, enums.WIDTH_LONG}
+
+	if result := database.Pg.Find(&listPatterns, "locale_id IN ? AND type = ? AND width IN ?", candidates, listType, widths); result.Error != nil {
+		return nil, result.Error
+	}
+
+	for _, candidate := range candidates {
+		for _, w := range widths {
+			for i := range listPatterns {
+				if listPatterns[i].LocaleID == candidate && listPatterns[i].Width == w {
+					return &listPatterns[i], nil
+				}
+			}
+		}
+	}
+
+	return nil, ErrListPatternNotFound
+}
+
+// FormatList method to join a list of items for a locale, e.g. "A, B and C".
+// Returns the joined list and the list pattern that was used.
+func FormatList(localeID string, items []string, listType enums.ListType, width enums.FormatWidth) (string, *models.ListPattern, error) {
+	listPattern, err := GetListPattern(localeID, listType, width)
+	if err != nil {
+		return "", nil, err
+	}
+
+	join := func(pattern, first, second string) string {
+		return strings.NewReplacer("{0}", first, "{1}", second).Replace(pattern)
+	}
+
+	switch len(items) {
+	case 0:
+		return "", listPattern, nil
+	case 1:
+		return items[0], listPattern, nil
+	case 2:
+		return join(listPattern.Two, items[0], items[1]), listPattern, nil
+	}
+
+	// Join from the end: the last two items with the end pattern, the others with the middle and start patterns.
+	joined := join(listPattern.End, items[len(items)-2], items[len(items)-1])
+	for i := len(items) - 3; i > 0; i-- {
+		joined = join(listPattern.Middle, items[i], joined)
+	}
+
+	return join(listPattern.Start, items[0], joined), listPattern, nil
+}
@@ -0,0 +1,374 @@
+package services
+
+import (
+	"api-i18n/main/src/cache"
+	"api-i18n/main/src/database"
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+)
+
+// localeDisplayNames holds the names of languages, scripts, territories and variants in a viewer locale.
+type localeDisplayNames struct {
+	pattern     models.LocaleDisplayPattern
+	languages   map[string]string
+	scripts     map[string]string
+	territories map[string]string
+	variants    map[string]string
+}
+
+// GetLocaleDisplayNames method to get the display names of all locales in a viewer locale, following the CLDR
+// locale display name algorithm. With the dialect display, a name for the language with its script or territory
+// is used when it exists, e.g. "British English"; with the standard display the language name is always qualified,
+// e.g. "English (United Kingdom)". The short width uses short language and territory names, e.g. "English (UK)".
+func GetLocaleDisplayNames(viewerID string, display enums.LanguageDisplay, width enums.FormatWidth) ([]models.LocaleName, error) {
+	locales := make([]models.Locale, 0)
+	if result := database.Pg.Order("id").Find(&locales); result.Error != nil {
+		return nil, result.Error
+	}
+
+	names, err := getLocaleDisplayNames(viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	localeNames := make([]models.LocaleName, 0, len(locales))
+	for i := range locales {
+		localeNames = append(localeNames, models.LocaleName{
+			LocaleIDViewer: viewerID,
+			LocaleIDTarget: locales[i].ID,
+			Name:           names.compose(&locales[i], display, width),
+		})
+	}
+
+	return localeNames, nil
+}
+
+// getLocaleDisplayNames loads the names and display pattern of a viewer locale.
+// The display pattern falls back to the parent locale and to the root pattern.
+func getLocaleDisplayNames(viewerID string) (*localeDisplayNames, error) {
+	names := newLocaleDisplayNames()
+
+	candidates := localeFallbacks(viewerID)
+	patterns := make([]models.LocaleDisplayPattern, 0)
+	if result := database.Pg.Find(&patterns, "locale_id IN ?", candidates); result.Error != nil {
+		return nil, result.Error
+	}
+	for _, candidate := range candidates {
+		if i := indexOfLocaleDisplayPattern(patterns, candidate); i >= 0 {
+			names.pattern = patterns[i]
+			break
+		}
+	}
+
+	localeNames := make([]models.LocaleName, 0)
+	if result := database.Pg.Find(&localeNames, "locale_id_viewer = ?", viewerID); result.Error != nil {
+		return nil, result.Error
+	}
+	for _, localeName := range localeNames {
+		names.languages[localeName.LocaleIDTarget] = localeName.Name
+	}
+
+	scriptNames := make([]models.ScriptName, 0)
+	if result := database.Pg.Find(&scriptNames, "locale_id = ?", viewerID); result.Error != nil {
+		return nil, result.Error
+	}
+	for _, scriptName := range scriptNames {
+		names.scripts[scriptName.ScriptID] = scriptName.Name
+	}
+
+	territoryNames := make([]models.TerritoryName, 0)
+	if result := database.Pg.Find(&territoryNames, "locale_id = ?", viewerID); result.Error != nil {
+		return nil, result.Error
+	}
+	for _, territoryName := range territoryNames {
+		names.territories[territoryName.TerritoryID] = territoryName.Name
+	}
+
+	variantNames := make([]models.VariantName, 0)
+	if result := database.Pg.Find(&variantNames, "locale_id = ?", viewerID); result.Error != nil {
+		return nil, result.Error
+	}
+	for _, variantName := range variantNames {
+		names.variants[strings.ToUpper(variantName.VariantID)] = variantName.Name
+	}
+
+	return names, nil
+}
+
+// GetLocaleDisplayName method to get the display name of one locale in a viewer locale.
+func GetLocaleDisplayName(viewerID string, locale *models.Locale, display enums.LanguageDisplay, width enums.FormatWidth) (string, error) {
+	names, err := getLocaleDisplayNames(viewerID)
+	if err != nil {
+		return "", err
+	}
+
+	return names.compose(locale, display, width), nil
+}
+
+// GetLocaleAutonyms method to get the autonym of every locale, its display name in the locale itself,
+// e.g. "Nederlands (België)" for nl-BE.
+func GetLocaleAutonyms(display enums.LanguageDisplay, width enums.FormatWidth) (map[string]string, error) {
+	if inCache, err := isLocaleAutonymsInCache(display, width); err != nil {
+		return nil, err
+	} else if inCache {
+		if cacheAutonyms, err := getLocaleAutonymsFromCache(display, width); err != nil {
+			return nil, err
+		} else if len(cacheAutonyms) > 0 {
+			return cacheAutonyms, nil
+		}
+	}
+
+	autonyms, err := getLocaleAutonyms(display, width)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = setLocaleAutonymsToCache(display, width, autonyms)
+
+	return autonyms, nil
+}
+
+// getLocaleAutonyms composes the autonyms of GetLocaleAutonyms; only the names needed for the autonyms are loaded.
+func getLocaleAutonyms(display enums.LanguageDisplay, width enums.FormatWidth) (map[string]string, error) {
+	locales := make([]models.Locale, 0)
+	if result := database.Pg.Find(&locales); result.Error != nil {
+		return nil, result.Error
+	}
+
+	patterns := make([]models.LocaleDisplayPattern, 0)
+	if result := database.Pg.Find(&patterns); result.Error != nil {
+		return nil, result.Error
+	}
+
+	localeNames := make([]models.LocaleName, 0)
+	if result := database.Pg.
+		Joins("JOIN locales ON locales.id = locale_names.locale_id_viewer").
+		Where(`locale_names.locale_id_target IN (locales.id, locales.language_id, locales.language_id || '-' || locales.script_id,
+			locales.language_id || '-' || locales.territory_id, locales.language_id || '-' || locales.script_id || '-' || locales.territory_id)`).
+		Find(&localeNames); result.Error != nil {
+		return nil, result.Error
+	}
+
+	scriptNames := make([]models.ScriptName, 0)
+	if result := database.Pg.
+		Joins("JOIN locales ON locales.id = script_names.locale_id AND locales.script_id = script_names.script_id").
+		Find(&scriptNames); result.Error != nil {
+		return nil, result.Error
+	}
+
+	territoryNames := make([]models.TerritoryName, 0)
+	if result := database.Pg.
+		Joins("JOIN locales ON locales.id = territory_names.locale_id AND (territory_names.territory_id = locales.territory_id OR territory_names.territory_id = locales.territory_id || '-alt-short')").
+		Find(&territoryNames); result.Error != nil {
+		return nil, result.Error
+	}
+
+	variantNames := make([]models.VariantName, 0)
+	if result := database.Pg.
+		Joins("JOIN locales ON locales.id = variant_names.locale_id AND UPPER(locales.variant_id) = UPPER(variant_names.variant_id)").
+		Find(&variantNames); result.Error != nil {
+		return nil, result.Error
+	}
+
+	viewers := make(map[string]*localeDisplayNames, len(locales))
+	for i := range locales {
+		names := newLocaleDisplayNames()
+		for _, candidate := range localeFallbacks(locales[i].ID) {
+			if j := indexOfLocaleDisplayPattern(patterns, candidate); j >= 0 {
+				names.pattern = patterns[j]
+				break
+			}
+		}
+		viewers[locales[i].ID] = names
+	}
+	for _, localeName := range localeNames {
+		viewers[localeName.LocaleIDViewer].languages[localeName.LocaleIDTarget] = localeName.Name
+	}
+	for _, scriptName := range scriptNames {
+		viewers[scriptName.LocaleID].scripts[scriptName.ScriptID] = scriptName.Name
+	}
+	for _, territoryName := range territoryNames {
+		viewers[territoryName.LocaleID].territories[territoryName.TerritoryID] = territoryName.Name
+	}
+	for _, variantName := range variantNames {
+		viewers[variantName.LocaleID].variants[strings.ToUpper(variantName.VariantID)] = variantName.Name
+	}
+
+	autonyms := make(map[string]string, len(locales))
+	for i := range locales {
+		autonyms[locales[i].ID] = viewers[locales[i].ID].compose(&locales[i], display, width)
+	}
+
+	return autonyms, nil
+}
+
+// isLocaleAutonymsInCache checks if the autonyms exist in the cache.
+func isLocaleAutonymsInCache(display enums.LanguageDisplay, width enums.FormatWidth) (bool, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Exists().Key(localeAutonymsCacheKey(display, width)).Build())
+	if result.Error() != nil {
+		return false, result.Error()
+	}
+
+	value, err := result.ToInt64()
+	if err != nil {
+		return false, err
+	}
+
+	return value == 1, nil
+}
+
+// getLocaleAutonymsFromCache gets the autonyms from the cache.
+func getLocaleAutonymsFromCache(display enums.LanguageDisplay, width enums.FormatWidth) (map[string]string, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Get().Key(localeAutonymsCacheKey(display, width)).Build())
+	if result.Error() != nil {
+		return nil, result.Error()
+	}
+
+	value, err := result.ToString()
+	if err != nil {
+		return nil, err
+	}
+
+	var autonyms map[string]string
+	if err := json.Unmarshal([]byte(value), &autonyms); err != nil {
+		return nil, err
+	}
+
+	return autonyms, nil
+}
+
+// setLocaleAutonymsToCache sets the autonyms to the cache.
+func setLocaleAutonymsToCache(display enums.LanguageDisplay, width enums.FormatWidth, autonyms map[string]string) error {
+	value, err := json.Marshal(autonyms)
+	if err != nil {
+		return err
+	}
+
+	expiration := os.Getenv("VALKEY_EXPIRATION")
+	duration, err := time.ParseDuration(expiration)
+	if err != nil {
+		return err
+	}
+
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Set().Key(localeAutonymsCacheKey(display, width)).Value(valkey.BinaryString(value)).Ex(duration).Build())
+	if result.Error() != nil {
+		return result.Error()
+	}
+
+	return nil
+}
+
+// localeAutonymsCacheKey returns the key for the autonyms cache.
+func localeAutonymsCacheKey(display enums.LanguageDisplay, width enums.FormatWidth) string {
+	return fmt.Sprintf("locales:autonyms:%s:%s", display, width)
+}
+
+// newLocaleDisplayNames returns empty display names with the root display pattern.
+func newLocaleDisplayNames() *localeDisplayNames {
+	return &localeDisplayNames{
+		pattern:     models.LocaleDisplayPattern{Pattern: "{0} ({1})", Separator: "{0}, {1}", KeyTypePattern: "{0}: {1}"},
+		languages:   make(map[string]string),
+		scripts:     make(map[string]string),
+		territories: make(map[string]string),
+		variants:    make(map[string]string),
+	}
+}
+
+// compose composes the display name of a locale, e.g. "English (United States)" or "Serbian (Latin, Bosnia & Herzegovina)".
+// Subtags without a name are shown as their code.
+func (n *localeDisplayNames) compose(locale *models.Locale, display enums.LanguageDisplay, width enums.FormatWidth) string {
+	script, territory, variant := locale.ScriptID.String, locale.TerritoryID.String, locale.VariantID.String
+
+	// With the dialect display, the longest language tag with a name is used and its subtags are not repeated.
+	languageTags := []string{locale.LanguageID}
+	if display == enums.DIALECT {
+		languageTags = make([]string, 0, 4)
+		if script != "" && territory != "" {
+			languageTags = append(languageTags, locale.LanguageID+"-"+script+"-"+territory)
+		}
+		if script != "" {
+			languageTags = append(languageTags, locale.LanguageID+"-"+script)
+		}
+		if territory != "" {
+			languageTags = append(languageTags, locale.LanguageID+"-"+territory)
+		}
+		languageTags = append(languageTags, locale.LanguageID)
+	}
+
+	name := locale.LanguageID
+	for _, tag := range languageTags {
+		languageName, ok := n.languages[tag]
+		if width == enums.WIDTH_SHORT {
+			if shortName, hasShort := n.pattern.ShortNames[tag]; hasShort {
+				languageName, ok = shortName, true
+			}
+		}
+		if !ok {
+			continue
+		}
+
+		name = languageName
+		rest := strings.TrimPrefix(tag, locale.LanguageID)
+		if strings.Contains(rest, "-"+script) && script != "" {
+			script = ""
+		}
+		if strings.HasSuffix(rest, "-"+territory) && territory != "" {
+			territory = ""
+		}
+		break
+	}
+
+	qualifiers := make([]string, 0, 3)
+	if script != "" {
+		qualifiers = append(qualifiers, n.name(n.scripts, script, width))
+	}
+	if territory != "" {
+		qualifiers = append(qualifiers, n.name(n.territories, territory, width))
+	}
+	if variant != "" {
+		qualifiers = append(qualifiers, n.name(n.variants, strings.ToUpper(variant), width))
+	}
+	if len(qualifiers) == 0 {
+		return name
+	}
+
+	qualifier := qualifiers[0]
+	for _, next := range qualifiers[1:] {
+		qualifier = strings.NewReplacer("{0}", qualifier, "{1}", next).Replace(n.pattern.Separator)
+	}
+
+	return strings.NewReplacer("{0}", name, "{1}", qualifier).Replace(n.pattern.Pattern)
+}
+
+// name returns the name of a subtag in the given width, or the subtag itself.
+func (n *localeDisplayNames) name(names map[string]string, subtag string, width enums.FormatWidth) string {
+	if width == enums.WIDTH_SHORT {
+		if shortName, ok := names[subtag+"-alt-short"]; ok {
+			return shortName
+		}
+	}
+	if name, ok := names[subtag]; ok {
+		return name
+	}
+
+	return subtag
+}
+
+// indexOfLocaleDisplayPattern returns the index of the display pattern of a locale, or -1.
+func indexOfLocaleDisplayPattern(patterns []models.LocaleDisplayPattern, localeID string) int {
+	for i := range patterns {
+		if patterns[i].LocaleID == localeID {
+			return i
+		}
+	}
+
+	return -1
+}
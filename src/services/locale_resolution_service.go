@@ -0,0 +1,315 @@
+package services
+
+import (
+	"api-i18n/main/src/database"
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// localeSubtags holds the likely subtags and aliases in memory; they only change when CLDR is seeded.
+var localeSubtags struct {
+	sync.Mutex
+	loaded  bool
+	likely  map[string]string
+	aliases map[enums.AliasType]map[string]string
+}
+
+// localeTag is a parsed BCP 47 language tag without extensions.
+type localeTag struct {
+	language string
+	script   string
+	region   string
+	variants []string
+}
+
+// GetLocaleCandidates method to get the locale IDs a requested locale may resolve to, best match first.
+// The tag is parsed case-insensitively with - or _ separators, aliases are replaced (e.g. iw = he),
+// and the candidates are the tag itself, its maximized and minimized forms and their fallbacks.
+// Example: zh-TW gives zh-TW, zh-Hant-TW, zh-Hant, zh.
+func GetLocaleCandidates(localeID string) []string {
+	likely, aliases := getLocaleSubtags()
+
+	return localeCandidates(localeID, likely, aliases)
+}
+
+// localeCandidates returns the candidates of GetLocaleCandidates for the given likely subtags and aliases.
+func localeCandidates(localeID string, likely map[string]string, aliases map[enums.AliasType]map[string]string) []string {
+	tag, ok := parseLocaleTag(localeID)
+	if !ok {
+		return nil
+	}
+
+	tag = replaceLocaleAliases(tag, likely, aliases)
+	maximized := addLikelySubtags(tag, likely)
+
+	candidates := make([]string, 0)
+	add := func(t localeTag) {
+		if id := t.String(); id != "" && !slices.Contains(candidates, id) {
+			candidates = append(candidates, id)
+		}
+	}
+
+	add(tag)
+	add(maximized)
+	for _, t := range []localeTag{tag, maximized} {
+		if len(t.variants) > 0 {
+			add(localeTag{language: t.language, script: t.script, region: t.region})
+		}
+	}
+
+	// Fallbacks keep the script: sr-Latn-RS falls back to sr-Latn before sr, which is written in Cyrillic.
+	// The language alone is the last resort.
+	if maximized.script != "" {
+		add(localeTag{language: maximized.language, script: maximized.script})
+	}
+	add(removeLikelySubtags(maximized, likely))
+	if tag.region != "" && (maximized.script == "" || addLikelySubtags(localeTag{language: maximized.language}, likely).script == maximized.script) {
+		add(localeTag{language: maximized.language, region: maximized.region})
+	}
+	add(localeTag{language: maximized.language})
+
+	return candidates
+}
+
+// GetAvailableLocaleIDs method to get which of the given locale IDs are available, in one query.
+// The IDs are matched case-insensitively, as variants are lowercase in candidates (en-US-posix) but not
+// always in CLDR (en-US-POSIX); the stored IDs are returned.
+func GetAvailableLocaleIDs(localeIDs []string) ([]string, error) {
+	available := make([]string, 0)
+	if len(localeIDs) == 0 {
+		return available, nil
+	}
+
+	lowerIDs := make([]string, len(localeIDs))
+	for i := range localeIDs {
+		lowerIDs[i] = strings.ToLower(localeIDs[i])
+	}
+
+	if result := database.Pg.Model(&models.Locale{}).Where("LOWER(id) IN ?", lowerIDs).Pluck("id", &available); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return available, nil
+}
+
+// getLocaleSubtags returns the likely subtags and aliases, loading them on first use.
+// When loading fails, empty maps are returned and loading is retried on the next call.
+func getLocaleSubtags() (map[string]string, map[enums.AliasType]map[string]string) {
+	localeSubtags.Lock()
+	defer localeSubtags.Unlock()
+
+	if localeSubtags.loaded {
+		return localeSubtags.likely, localeSubtags.aliases
+	}
+
+	likely := make(map[string]string)
+	aliases := make(map[enums.AliasType]map[string]string)
+
+	likelySubtags := make([]models.LikelySubtag, 0)
+	if result := database.Pg.Find(&likelySubtags); result.Error != nil {
+		return likely, aliases
+	}
+	localeAliases := make([]models.LocaleAlias, 0)
+	if result := database.Pg.Find(&localeAliases); result.Error != nil {
+		return likely, aliases
+	}
+
+	for _, likelySubtag := range likelySubtags {
+		likely[strings.ToLower(likelySubtag.Tag)] = likelySubtag.Maximized
+	}
+	for _, localeAlias := range localeAliases {
+		if aliases[localeAlias.Type] == nil {
+			aliases[localeAlias.Type] = make(map[string]string)
+		}
+		aliases[localeAlias.Type][strings.ToLower(localeAlias.Alias)] = localeAlias.Replacement
+	}
+
+	localeSubtags.loaded = true
+	localeSubtags.likely, localeSubtags.aliases = likely, aliases
+
+	return likely, aliases
+}
+
+// parseLocaleTag parses a language tag case-insensitively; extensions and private use subtags are ignored.
+func parseLocaleTag(localeID string) (localeTag, bool) {
+	parts := strings.FieldsFunc(strings.TrimSpace(localeID), func(r rune) bool { return r == '-' || r == '_' })
+	if len(parts) == 0 || !isLocaleSubtag(parts[0], 2, 8, true) || len(parts[0]) == 4 {
+		return localeTag{}, false
+	}
+
+	tag := localeTag{language: strings.ToLower(parts[0])}
+	if tag.language == "root" {
+		tag.language = "und"
+	}
+
+	for _, part := range parts[1:] {
+		switch {
+		case len(part) == 1:
+			return tag, true // Extensions (-u-, -t-) and private use (-x-) follow.
+		case tag.script == "" && tag.region == "" && len(tag.variants) == 0 && len(part) == 4 && isLocaleSubtag(part, 4, 4, true):
+			tag.script = strings.ToUpper(part[:1]) + strings.ToLower(part[1:])
+		case tag.region == "" && len(tag.variants) == 0 && (isLocaleSubtag(part, 2, 2, true) || (len(part) == 3 && strings.Trim(part, "0123456789") == "")):
+			tag.region = strings.ToUpper(part)
+		case isLocaleSubtag(part, 5, 8, false) || (len(part) == 4 && part[0] >= '0' && part[0] <= '9'):
+			tag.variants = append(tag.variants, strings.ToLower(part))
+		default:
+			return localeTag{}, false
+		}
+	}
+
+	return tag, true
+}
+
+// isLocaleSubtag checks the length of a subtag and whether it has letters only or letters and digits.
+func isLocaleSubtag(subtag string, minLength, maxLength int, lettersOnly bool) bool {
+	if len(subtag) < minLength || len(subtag) > maxLength {
+		return false
+	}
+
+	for _, r := range subtag {
+		isLetter := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		if !isLetter && (lettersOnly || r < '0' || r > '9') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns the tag in canonical form, e.g. zh-Hant-TW.
+func (t localeTag) String() string {
+	if t.language == "" {
+		return ""
+	}
+
+	parts := []string{t.language}
+	for _, part := range []string{t.script, t.region} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	return strings.Join(append(parts, t.variants...), "-")
+}
+
+// replaceLocaleAliases replaces deprecated and legacy subtags, e.g. iw = he, sh = sr-Latn, DD = DE.
+func replaceLocaleAliases(tag localeTag, likely map[string]string, aliases map[enums.AliasType]map[string]string) localeTag {
+	// Language aliases may include a variant or region, e.g. art-lojban or sgn-BR.
+	keys := make([]string, 0, len(tag.variants)+2)
+	for _, variant := range tag.variants {
+		keys = append(keys, tag.language+"-"+variant)
+	}
+	if tag.region != "" {
+		keys = append(keys, tag.language+"-"+strings.ToLower(tag.region))
+	}
+	keys = append(keys, tag.language)
+
+	for _, key := range keys {
+		replacement, ok := aliases[enums.ALIAS_LANGUAGE][key]
+		if !ok {
+			continue
+		}
+		replacementTag, ok := parseLocaleTag(replacement)
+		if !ok {
+			break
+		}
+
+		_, matched, _ := strings.Cut(key, "-")
+		tag.variants = slices.DeleteFunc(tag.variants, func(variant string) bool { return variant == matched })
+		if strings.EqualFold(matched, tag.region) {
+			tag.region = ""
+		}
+
+		tag.language = replacementTag.language
+		if tag.script == "" {
+			tag.script = replacementTag.script
+		}
+		if tag.region == "" {
+			tag.region = replacementTag.region
+		}
+		tag.variants = append(tag.variants, replacementTag.variants...)
+		break
+	}
+
+	if replacement, ok := aliases[enums.ALIAS_SCRIPT][strings.ToLower(tag.script)]; ok && tag.script != "" {
+		tag.script = replacement
+	}
+
+	if replacement, ok := aliases[enums.ALIAS_TERRITORY][strings.ToLower(tag.region)]; ok && tag.region != "" {
+		// A split territory like SU is replaced with the likely territory of the language when it is one of the replacements.
+		regions := strings.Fields(replacement)
+		tag.region = regions[0]
+		if likelyRegion := addLikelySubtags(localeTag{language: tag.language, script: tag.script}, likely).region; slices.Contains(regions, likelyRegion) {
+			tag.region = likelyRegion
+		}
+	}
+
+	for i, variant := range tag.variants {
+		if replacement, ok := aliases[enums.ALIAS_VARIANT][variant]; ok {
+			tag.variants[i] = strings.ToLower(replacement)
+		}
+	}
+
+	return tag
+}
+
+// addLikelySubtags fills in the missing script and region of a tag with their likely values, e.g. zh-TW = zh-Hant-TW.
+func addLikelySubtags(tag localeTag, likely map[string]string) localeTag {
+	lookups := make([]localeTag, 0, 8)
+	for _, language := range []string{tag.language, "und"} {
+		if language == "und" && tag.language == "und" && len(lookups) > 0 {
+			break
+		}
+		lookups = append(lookups,
+			localeTag{language: language, script: tag.script, region: tag.region},
+			localeTag{language: language, region: tag.region},
+			localeTag{language: language, script: tag.script},
+			localeTag{language: language},
+		)
+	}
+
+	for _, lookup := range lookups {
+		maximized, ok := likely[strings.ToLower(lookup.String())]
+		if !ok {
+			continue
+		}
+		match, ok := parseLocaleTag(maximized)
+		if !ok {
+			continue
+		}
+
+		if tag.language == "und" {
+			tag.language = match.language
+		}
+		if tag.script == "" {
+			tag.script = match.script
+		}
+		if tag.region == "" {
+			tag.region = match.region
+		}
+		return tag
+	}
+
+	return tag
+}
+
+// removeLikelySubtags returns the shortest tag that maximizes to the same tag, e.g. zh-Hant-TW = zh-TW, sr-Cyrl-RS = sr.
+func removeLikelySubtags(tag localeTag, likely map[string]string) localeTag {
+	maximized := addLikelySubtags(localeTag{language: tag.language, script: tag.script, region: tag.region}, likely)
+
+	for _, trial := range []localeTag{
+		{language: tag.language},
+		{language: tag.language, region: tag.region},
+		{language: tag.language, script: tag.script},
+	} {
+		if addLikelySubtags(trial, likely).String() == maximized.String() {
+			trial.variants = tag.variants
+			return trial
+		}
+	}
+
+	return tag
+}
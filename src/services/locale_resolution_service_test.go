@@ -0,0 +1,63 @@
+package services
+
+import (
+	"api-i18n/main/src/enums"
+	"slices"
+	"testing"
+)
+
+// testLikelySubtags are likely subtags of CLDR, keyed by lowercase tag like the loaded ones.
+var testLikelySubtags = map[string]string{
+	"en":      "en-Latn-US",
+	"zh":      "zh-Hans-CN",
+	"zh-tw":   "zh-Hant-TW",
+	"zh-hant": "zh-Hant-TW",
+	"sr":      "sr-Cyrl-RS",
+	"sr-latn": "sr-Latn-RS",
+	"he":      "he-Hebr-IL",
+	"az":      "az-Latn-AZ",
+	"az-arab": "az-Arab-IR",
+	"und":     "en-Latn-US",
+	"und-de":  "de-Latn-DE",
+	"de":      "de-Latn-DE",
+	"ru":      "ru-Cyrl-RU",
+}
+
+// testLocaleAliases are aliases of CLDR, keyed by lowercase alias like the loaded ones.
+var testLocaleAliases = map[enums.AliasType]map[string]string{
+	enums.ALIAS_LANGUAGE:  {"iw": "he", "sh": "sr-Latn"},
+	enums.ALIAS_TERRITORY: {"dd": "DE", "su": "RU AM AZ"},
+	enums.ALIAS_VARIANT:   {"heploc": "alalc97"},
+}
+
+func TestLocaleCandidates(t *testing.T) {
+	tests := []struct {
+		localeID string
+		want     []string
+	}{
+		{localeID: "en", want: []string{"en", "en-Latn-US", "en-Latn"}},
+		{localeID: "EN_us", want: []string{"en-US", "en-Latn-US", "en-Latn", "en"}},
+		{localeID: "en-US-POSIX", want: []string{"en-US-posix", "en-Latn-US-posix", "en-US", "en-Latn-US", "en-Latn", "en-posix", "en"}},
+		{localeID: "zh-TW", want: []string{"zh-TW", "zh-Hant-TW", "zh-Hant", "zh"}},
+		{localeID: "iw", want: []string{"he", "he-Hebr-IL", "he-Hebr"}},
+		{localeID: "sh", want: []string{"sr-Latn", "sr-Latn-RS", "sr"}},
+		{localeID: "sr-RS", want: []string{"sr-RS", "sr-Cyrl-RS", "sr-Cyrl", "sr"}},
+		{localeID: "az-Arab-IQ", want: []string{"az-Arab-IQ", "az-Arab", "az"}},
+		{localeID: "de-DD", want: []string{"de-DE", "de-Latn-DE", "de-Latn", "de"}},
+		{localeID: "ru-SU", want: []string{"ru-RU", "ru-Cyrl-RU", "ru-Cyrl", "ru"}},
+		{localeID: "de-CH-u-co-phonebk", want: []string{"de-CH", "de-Latn-CH", "de-Latn", "de"}},
+		{localeID: "e", want: nil},
+		{localeID: "en-US-$", want: []string{"en-US", "en-Latn-US", "en-Latn", "en"}},
+		{localeID: "$", want: nil},
+		{localeID: "en-abc!", want: nil},
+		{localeID: "", want: nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.localeID, func(t *testing.T) {
+			if got := localeCandidates(test.localeID, testLikelySubtags, testLocaleAliases); !slices.Equal(got, test.want) {
+				t.Errorf("localeCandidates(%q) = %v, want %v", test.localeID, got, test.want)
+			}
+		})
+	}
+}
@@ -3,12 +3,12 @@ package services
 import (
 	"api-i18n/main/src/cache"
 	"api-i18n/main/src/database"
+	"api-i18n/main/src/enums"
 	"api-i18n/main/src/models"
 	"context"
 	"encoding/json"
 	"fmt"
 	"os"
-	"strings"
 	"time"
 
 	"github.com/valkey-io/valkey-go"
@@ -23,14 +23,53 @@ func IsLocaleAvailable(localeID string) (bool, error) {
 	}
 }
 
-// GetLocaleLookup method to get locale lookup names.
-func GetLocaleLookup(localeID string, name *string) (*[]models.LocaleName, error) {
+// GetLocaleMetadata method to get the metadata of a locale with the locale itself.
+func GetLocaleMetadata(localeID string) (*models.LocaleMetadata, error) {
+	localeMetadata := &models.LocaleMetadata{}
+
+	if result := database.Pg.Preload("Locale").Find(localeMetadata, "locale_id = ?", localeID); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return localeMetadata, nil
+}
+
+// GetLocaleMetadataList method to get the metadata of all locales by locale ID.
+func GetLocaleMetadataList() (map[string]models.LocaleMetadata, error) {
+	if inCache, err := isLocaleMetadataListInCache(); err != nil {
+		return nil, err
+	} else if inCache {
+		if cacheMetadata, err := getLocaleMetadataListFromCache(); err != nil {
+			return nil, err
+		} else if len(cacheMetadata) > 0 {
+			return cacheMetadata, nil
+		}
+	}
+
+	localeMetadata := make([]models.LocaleMetadata, 0)
+	if result := database.Pg.Find(&localeMetadata); result.Error != nil {
+		return nil, result.Error
+	}
+
+	metadata := make(map[string]models.LocaleMetadata, len(localeMetadata))
+	for _, m := range localeMetadata {
+		metadata[m.LocaleID] = m
+	}
+
+	_ = setLocaleMetadataListToCache(metadata)
+
+	return metadata, nil
+}
+
+// GetLocaleLookup method to get locale lookup names, composed for every locale in the given display and width.
+// The locales are sorted by name with the collation of the locale.
+func GetLocaleLookup(localeID string, display enums.LanguageDisplay, width enums.FormatWidth, name *string) (*[]models.LocaleName, error) {
 	locales := make([]models.LocaleName, 0)
 
-	if inCache, err := isLocalesLookupInCache(localeID); err != nil {
+	if inCache, err := isLocalesLookupInCache(localeID, display, width); err != nil {
 		return nil, err
 	} else if inCache {
-		if cacheLocales, err := getLocalesLookupFromCache(localeID); err != nil {
+		if cacheLocales, err := getLocalesLookupFromCache(localeID, display, width); err != nil {
 			return nil, err
 		} else if cacheLocales != nil && len(*cacheLocales) > 0 {
 			locales = *cacheLocales
@@ -38,37 +77,27 @@ func GetLocaleLookup(localeID string, name *string) (*[]models.LocaleName, error
 	}
 
 	if len(locales) == 0 {
-		query := database.Pg.Model(&models.LocaleName{}).
-			Select("locale_id_target", "name")
-
-		if result := query.Find(&locales, "locale_id_viewer = ?", localeID); result.Error != nil {
-			return nil, result.Error
+		displayNames, err := GetLocaleDisplayNames(localeID, display, width)
+		if err != nil {
+			return nil, err
 		}
+		locales = displayNames
+		sortByCollation(localeID, locales, func(l *models.LocaleName) string { return l.Name })
 
-		_ = setLocalesLookupToCache(localeID, &locales)
+		_ = setLocalesLookupToCache(localeID, display, width, &locales)
 	}
 
-	// If a name filter is provided, perform case-insensitive substring match on the list
+	// If a name filter is provided, match it ignoring case and accents, names starting with it first
 	if name != nil {
-		target := strings.TrimSpace(*name)
-		if target != "" {
-			lowerTarget := strings.ToLower(target)
-			filtered := make([]models.LocaleName, 0, len(locales))
-			for i := range locales {
-				if strings.Contains(strings.ToLower(locales[i].Name), lowerTarget) {
-					filtered = append(filtered, locales[i])
-				}
-			}
-			locales = filtered
-		}
+		locales = filterByName(localeID, locales, *name, func(x *models.LocaleName) string { return x.Name })
 	}
 
 	return &locales, nil
 }
 
 // isLocalesLookupInCache checks if the locales exists in the cache.
-func isLocalesLookupInCache(localeID string) (bool, error) {
-	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Exists().Key(localeLookupCacheKey(localeID)).Build())
+func isLocalesLookupInCache(localeID string, display enums.LanguageDisplay, width enums.FormatWidth) (bool, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Exists().Key(localeLookupCacheKey(localeID, display, width)).Build())
 	if result.Error() != nil {
 		return false, result.Error()
 	}
@@ -82,8 +111,8 @@ func isLocalesLookupInCache(localeID string) (bool, error) {
 }
 
 // getLocalesLookupFromCache gets the locales from the cache.
-func getLocalesLookupFromCache(localeID string) (*[]models.LocaleName, error) {
-	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Get().Key(localeLookupCacheKey(localeID)).Build())
+func getLocalesLookupFromCache(localeID string, display enums.LanguageDisplay, width enums.FormatWidth) (*[]models.LocaleName, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Get().Key(localeLookupCacheKey(localeID, display, width)).Build())
 	if result.Error() != nil {
 		return nil, result.Error()
 	}
@@ -102,7 +131,7 @@ func getLocalesLookupFromCache(localeID string) (*[]models.LocaleName, error) {
 }
 
 // setLocalesLookupToCache sets the locales to the cache.
-func setLocalesLookupToCache(localeID string, locales *[]models.LocaleName) error {
+func setLocalesLookupToCache(localeID string, display enums.LanguageDisplay, width enums.FormatWidth, locales *[]models.LocaleName) error {
 	value, err := json.Marshal(locales)
 	if err != nil {
 		return err
@@ -114,7 +143,7 @@ func setLocalesLookupToCache(localeID string, locales *[]models.LocaleName) erro
 		return err
 	}
 
-	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Set().Key(localeLookupCacheKey(localeID)).Value(valkey.BinaryString(value)).Ex(duration).Build())
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Set().Key(localeLookupCacheKey(localeID, display, width)).Value(valkey.BinaryString(value)).Ex(duration).Build())
 	if result.Error() != nil {
 		return result.Error()
 	}
@@ -123,6 +152,65 @@ func setLocalesLookupToCache(localeID string, locales *[]models.LocaleName) erro
 }
 
 // localeLookupCacheKey returns the key for the locales cache.
-func localeLookupCacheKey(localeID string) string {
-	return fmt.Sprintf("locales:lookup:%s", localeID)
+func localeLookupCacheKey(localeID string, display enums.LanguageDisplay, width enums.FormatWidth) string {
+	return fmt.Sprintf("locales:lookup:%s:%s:%s", localeID, display, width)
+}
+
+// isLocaleMetadataListInCache checks if the metadata of all locales exists in the cache.
+func isLocaleMetadataListInCache() (bool, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Exists().Key(localeMetadataListCacheKey).Build())
+	if result.Error() != nil {
+		return false, result.Error()
+	}
+
+	value, err := result.ToInt64()
+	if err != nil {
+		return false, err
+	}
+
+	return value == 1, nil
 }
+
+// getLocaleMetadataListFromCache gets the metadata of all locales from the cache.
+func getLocaleMetadataListFromCache() (map[string]models.LocaleMetadata, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Get().Key(localeMetadataListCacheKey).Build())
+	if result.Error() != nil {
+		return nil, result.Error()
+	}
+
+	value, err := result.ToString()
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata map[string]models.LocaleMetadata
+	if err := json.Unmarshal([]byte(value), &metadata); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// setLocaleMetadataListToCache sets the metadata of all locales to the cache.
+func setLocaleMetadataListToCache(metadata map[string]models.LocaleMetadata) error {
+	value, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+
+	expiration := os.Getenv("VALKEY_EXPIRATION")
+	duration, err := time.ParseDuration(expiration)
+	if err != nil {
+		return err
+	}
+
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Set().Key(localeMetadataListCacheKey).Value(valkey.BinaryString(value)).Ex(duration).Build())
+	if result.Error() != nil {
+		return result.Error()
+	}
+
+	return nil
+}
+
+// localeMetadataListCacheKey is the key for the cache of the metadata of all locales.
+const localeMetadataListCacheKey = "locales:metadata"
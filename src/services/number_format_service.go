@@ -0,0 +1,562 @@
+package services
+
+import (
+	"api-i18n/main/src/database"
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+	"errors"
+	"math/big"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	// ErrInvalidNumber is returned when a number cannot be parsed.
+	ErrInvalidNumber = errors.New("invalid number")
+	// ErrNumberFormatNotFound is returned when a locale has no number format for the numbering system.
+	ErrNumberFormatNotFound = errors.New("number format not found")
+	// ErrCurrencyNotFound is returned when a currency does not exist.
+	ErrCurrencyNotFound = errors.New("currency not found")
+)
+
+const (
+	// numberMaxLength is the maximum length of a number to format.
+	numberMaxLength = 100
+	// numberMaxExponent is the maximum exponent of a number to format, so 1e1000000 can't render a huge number.
+	numberMaxExponent = 100
+)
+
+// numberDecimal matches a plain decimal number with an optional exponent, e.g. -1234.5 or 1.2e6.
+var numberDecimal = regexp.MustCompile(`^[-+]?(?:\d+(?:\.\d*)?|\.\d+)(?:[eE]([-+]?\d{1,3}))?$`)
+
+// numberSpaces are the whitespace characters that are used as or confused with group separators.
+var numberSpaces = []string{" ", "\u00a0", "\u202f"}
+
+// numberMarks are the bidi marks that may surround numbers and signs.
+var numberMarks = []string{"\u200e", "\u200f", "\u061c"}
+
+// NumberFormatOptions holds the options of a number to format or parse.
+type NumberFormatOptions struct {
+	Style                 enums.NumberStyle
+	NumberingSystemID     *string
+	CurrencyID            *string
+	CurrencyDisplay       enums.CurrencyDisplay
+	MinimumFractionDigits *int
+	MaximumFractionDigits *int
+	Cash                  bool
+}
+
+// numberPattern is a parsed CLDR number pattern like "#,##0.00;(#,##0.00)".
+type numberPattern struct {
+	positivePrefix string
+	positiveSuffix string
+	negativePrefix string
+	negativeSuffix string
+	minInteger     int
+	minFraction    int
+	maxFraction    int
+	primaryGroup   int
+	secondaryGroup int
+}
+
+// numberCurrency is the currency of a formatted or parsed amount.
+type numberCurrency struct {
+	currency models.Currency
+	name     models.CurrencyName
+	symbol   string
+}
+
+// GetNumberFormat method to get the number format of a locale for a numbering system, or its default numbering system.
+// Locales without number format fall back to their parent, e.g. de-CH uses de.
+func GetNumberFormat(localeID string, numberingSystemID *string) (*models.NumberFormat, error) {
+	numberFormats := make([]models.NumberFormat, 0)
+	candidates := localeFallbacks(localeID)
+
+	query := database.Pg.Preload("NumberingSystem").Where("locale_id IN ?", candidates)
+	if numberingSystemID != nil {
+		query = query.Where("numbering_system_id = ?", *numberingSystemID)
+	} else {
+		query = query.Where(`"default" = ?`, true)
+	}
+
+	if result := query.Find(&numberFormats); result.Error != nil {
+		return nil, result.Error
+	}
+
+	for _, candidate := range candidates {
+		for i := range numberFormats {
+			if numberFormats[i].LocaleID == candidate {
+				return &numberFormats[i], nil
+			}
+		}
+	}
+
+	return nil, ErrNumberFormatNotFound
+}
+
+// FormatNumber method to format a decimal number for a locale in the given style.
+// The number is a plain decimal like -1234.5; it is rounded half away from zero.
+// Currency amounts are rounded with the rounding increment of the currency, or its cash rounding, e.g. 0.05 for CHF.
+// Returns the formatted number and the number format that was used.
+func FormatNumber(localeID, number string, options NumberFormatOptions) (string, *models.NumberFormat, error) {
+	value, err := parseDecimal(number)
+	if err != nil {
+		return "", nil, err
+	}
+
+	numberFormat, err := GetNumberFormat(localeID, options.NumberingSystemID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var currency *numberCurrency
+	if options.Style.IsCurrency() {
+		if currency, err = getNumberCurrency(numberFormat.LocaleID, options); err != nil {
+			return "", nil, err
+		}
+	}
+
+	return formatNumber(value, numberFormat, currency, options), numberFormat, nil
+}
+
+// formatNumber formats a number with a number format and, for currency styles, its currency.
+func formatNumber(value *big.Rat, numberFormat *models.NumberFormat, currency *numberCurrency, options NumberFormatOptions) string {
+	value = new(big.Rat).Set(value)
+	pattern := parseNumberPattern(numberStylePattern(numberFormat, options.Style))
+
+	rounding, roundingDigits := 0, 0
+	if currency != nil {
+		digits := currency.currency.Digits
+		rounding = currency.currency.Rounding
+		if options.Cash {
+			if currency.currency.CashDigits.Valid {
+				digits = int(currency.currency.CashDigits.Int64)
+			}
+			if currency.currency.CashRounding.Valid {
+				rounding = int(currency.currency.CashRounding.Int64)
+			}
+		}
+		pattern.minFraction, pattern.maxFraction = digits, digits
+		roundingDigits = digits
+	}
+	if options.Style == enums.PERCENT {
+		value.Mul(value, big.NewRat(100, 1))
+	}
+	if options.MinimumFractionDigits != nil {
+		pattern.minFraction = *options.MinimumFractionDigits
+		pattern.maxFraction = max(pattern.maxFraction, pattern.minFraction)
+	}
+	if options.MaximumFractionDigits != nil {
+		pattern.maxFraction = *options.MaximumFractionDigits
+		pattern.minFraction = min(pattern.minFraction, pattern.maxFraction)
+		rounding = 0
+	}
+	if rounding > 1 {
+		value = roundNumberIncrement(value, rounding, roundingDigits)
+	}
+
+	// Round the absolute value and split it into integer and fraction digits.
+	negative := value.Sign() < 0
+	digits := new(big.Rat).Abs(value).FloatString(pattern.maxFraction)
+	integer, fraction, _ := strings.Cut(digits, ".")
+	for len(fraction) > pattern.minFraction && strings.HasSuffix(fraction, "0") {
+		fraction = fraction[:len(fraction)-1]
+	}
+	if strings.Trim(integer+fraction, "0") == "" {
+		negative = false
+	}
+	if integer == "0" && pattern.minInteger == 0 && fraction != "" {
+		integer = ""
+	}
+	for len(integer) < pattern.minInteger {
+		integer = "0" + integer
+	}
+
+	var builder strings.Builder
+	builder.WriteString(groupNumber(integer, pattern, numberFormat))
+	if fraction != "" {
+		builder.WriteString(numberFormat.Decimal)
+		builder.WriteString(fraction)
+	}
+	formatted := nativeDigits(builder.String(), numberFormat.NumberingSystem.Digits)
+
+	prefix, suffix := pattern.positivePrefix, pattern.positiveSuffix
+	if negative {
+		prefix, suffix = pattern.negativePrefix, pattern.negativeSuffix
+	}
+
+	return renderNumberAffix(prefix, numberFormat, currency, true) + formatted + renderNumberAffix(suffix, numberFormat, currency, false)
+}
+
+// ParseNumber method to parse a number formatted for a locale in the given style back to a plain decimal.
+// Parsing is lenient: group separators, spaces, bidi marks and currency symbols of the locale are ignored,
+// a minus sign or accounting parentheses make the number negative.
+func ParseNumber(localeID, value string, options NumberFormatOptions) (string, *models.NumberFormat, error) {
+	numberFormat, err := GetNumberFormat(localeID, options.NumberingSystemID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	value = asciiDigits(strings.TrimSpace(value), numberFormat.NumberingSystem.Digits)
+
+	if options.Style.IsCurrency() {
+		currency, err := getNumberCurrency(numberFormat.LocaleID, options)
+		if err != nil {
+			return "", nil, err
+		}
+		for _, symbol := range []string{currency.name.Symbol, currency.name.NarrowSymbol, currency.currency.ID} {
+			if symbol != "" {
+				value = strings.ReplaceAll(value, symbol, "")
+			}
+		}
+	}
+
+	ignored := append([]string{numberFormat.Group}, numberMarks...)
+	if strings.TrimSpace(numberFormat.Group) == "" || slices.Contains(numberSpaces, numberFormat.Group) {
+		ignored = append(ignored, numberSpaces...)
+	}
+	if options.Style == enums.PERCENT {
+		ignored = append(ignored, numberFormat.PercentSign, "%")
+	}
+	minusSigns := []string{numberFormat.MinusSign, "-", "\u2212"}
+	plusSigns := []string{numberFormat.PlusSign, "+"}
+
+	negative, parentheses := false, 0
+	var builder strings.Builder
+	for len(value) > 0 {
+		switch {
+		case value[0] >= '0' && value[0] <= '9':
+			builder.WriteByte(value[0])
+			value = value[1:]
+		case strings.HasPrefix(value, numberFormat.Decimal):
+			if strings.Contains(builder.String(), ".") {
+				return "", nil, ErrInvalidNumber
+			}
+			builder.WriteString(".")
+			value = value[len(numberFormat.Decimal):]
+		case value[0] == '(' || value[0] == ')':
+			parentheses++
+			value = value[1:]
+		default:
+			if prefix, ok := numberPrefix(value, minusSigns); ok {
+				negative = true
+				value = value[len(prefix):]
+			} else if prefix, ok := numberPrefix(value, plusSigns); ok {
+				value = value[len(prefix):]
+			} else if prefix, ok := numberPrefix(value, ignored); ok {
+				value = value[len(prefix):]
+			} else {
+				return "", nil, ErrInvalidNumber
+			}
+		}
+	}
+	if parentheses == 2 {
+		negative = true
+	} else if parentheses != 0 {
+		return "", nil, ErrInvalidNumber
+	}
+
+	digits := builder.String()
+	number, ok := new(big.Rat).SetString(digits)
+	if !ok || strings.Trim(digits, ".") == "" {
+		return "", nil, ErrInvalidNumber
+	}
+	if negative {
+		number.Neg(number)
+	}
+
+	_, fraction, _ := strings.Cut(digits, ".")
+	precision := len(fraction)
+	if options.Style == enums.PERCENT {
+		number.Quo(number, big.NewRat(100, 1))
+		precision += 2
+	}
+
+	return number.FloatString(precision), numberFormat, nil
+}
+
+// numberStylePattern returns the pattern of a number format for a style.
+func numberStylePattern(numberFormat *models.NumberFormat, style enums.NumberStyle) string {
+	switch style {
+	case enums.PERCENT:
+		return numberFormat.PercentPattern
+	case enums.CURRENCY:
+		return numberFormat.CurrencyPattern
+	case enums.ACCOUNTING:
+		return numberFormat.AccountingPattern
+	default:
+		return numberFormat.DecimalPattern
+	}
+}
+
+// getNumberCurrency gets the currency of the options with its name and symbol in a locale.
+func getNumberCurrency(localeID string, options NumberFormatOptions) (*numberCurrency, error) {
+	if options.CurrencyID == nil {
+		return nil, ErrCurrencyNotFound
+	}
+
+	currency := &numberCurrency{}
+	if result := database.Pg.Limit(1).Find(&currency.currency, "id = ?", strings.ToUpper(*options.CurrencyID)); result.Error != nil {
+		return nil, result.Error
+	} else if result.RowsAffected == 0 {
+		return nil, ErrCurrencyNotFound
+	}
+
+	if result := database.Pg.Limit(1).Find(&currency.name, "currency_id = ? AND locale_id = ?", currency.currency.ID, localeID); result.Error != nil {
+		return nil, result.Error
+	}
+
+	switch {
+	case options.CurrencyDisplay == enums.CODE || currency.name.Symbol == "":
+		currency.symbol = currency.currency.ID
+	case options.CurrencyDisplay == enums.NARROW_SYMBOL && currency.name.NarrowSymbol != "":
+		currency.symbol = currency.name.NarrowSymbol
+	default:
+		currency.symbol = currency.name.Symbol
+	}
+
+	return currency, nil
+}
+
+// parseNumberPattern parses a CLDR number pattern. Without negative subpattern, negative numbers
+// get a minus sign before the positive prefix.
+func parseNumberPattern(pattern string) numberPattern {
+	positive, negative, hasNegative := cutNumberPattern(pattern)
+
+	result := numberPattern{}
+	var body string
+	result.positivePrefix, body, result.positiveSuffix = splitNumberPattern(positive)
+	if hasNegative {
+		result.negativePrefix, _, result.negativeSuffix = splitNumberPattern(negative)
+	} else {
+		result.negativePrefix, result.negativeSuffix = "-"+result.positivePrefix, result.positiveSuffix
+	}
+
+	integer, fraction, _ := strings.Cut(body, ".")
+	result.minInteger = strings.Count(integer, "0")
+	result.minFraction = strings.Count(fraction, "0")
+	result.maxFraction = strings.Count(fraction, "0") + strings.Count(fraction, "#")
+
+	groups := strings.Split(integer, ",")
+	if len(groups) > 1 {
+		result.primaryGroup = len(groups[len(groups)-1])
+		result.secondaryGroup = result.primaryGroup
+		if len(groups) > 2 {
+			result.secondaryGroup = len(groups[len(groups)-2])
+		}
+	}
+
+	return result
+}
+
+// cutNumberPattern cuts a pattern into its positive and negative subpattern, ignoring quoted semicolons.
+func cutNumberPattern(pattern string) (string, string, bool) {
+	quoted := false
+	for i, r := range pattern {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case r == ';' && !quoted:
+			return pattern[:i], pattern[i+1:], true
+		}
+	}
+
+	return pattern, "", false
+}
+
+// splitNumberPattern splits a subpattern into its prefix, number body and suffix.
+func splitNumberPattern(pattern string) (string, string, string) {
+	start, end, quoted := -1, -1, false
+	for i, r := range pattern {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case !quoted && strings.ContainsRune("#0,.", r):
+			if start < 0 {
+				start = i
+			}
+			end = i + 1
+		}
+	}
+	if start < 0 {
+		return pattern, "", ""
+	}
+
+	return pattern[:start], pattern[start:end], pattern[end:]
+}
+
+// groupNumber inserts the group separators of a locale in integer digits.
+func groupNumber(integer string, pattern numberPattern, numberFormat *models.NumberFormat) string {
+	if pattern.primaryGroup <= 0 || len(integer) < pattern.primaryGroup+numberFormat.MinimumGroupingDigits {
+		return integer
+	}
+
+	groups := []string{integer[len(integer)-pattern.primaryGroup:]}
+	integer = integer[:len(integer)-pattern.primaryGroup]
+	for len(integer) > pattern.secondaryGroup {
+		groups = append([]string{integer[len(integer)-pattern.secondaryGroup:]}, groups...)
+		integer = integer[:len(integer)-pattern.secondaryGroup]
+	}
+	if integer != "" {
+		groups = append([]string{integer}, groups...)
+	}
+
+	return strings.Join(groups, numberFormat.Group)
+}
+
+// renderNumberAffix renders a pattern prefix or suffix with the symbols of a number format.
+// A currency symbol that ends (prefix) or starts (suffix) with a letter is separated from the number by a no-break space.
+func renderNumberAffix(affix string, numberFormat *models.NumberFormat, currency *numberCurrency, isPrefix bool) string {
+	var builder strings.Builder
+	quoted := false
+
+	for i := 0; i < len(affix); {
+		r, size := utf8.DecodeRuneInString(affix[i:])
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case quoted:
+			builder.WriteRune(r)
+		case r == '¤':
+			for strings.HasPrefix(affix[i+size:], "¤") {
+				size += len("¤")
+			}
+			if currency == nil {
+				break
+			}
+			symbol := currency.affixSymbol(size / len("¤"))
+			if !isPrefix && i == 0 && startsWithLetter(symbol) {
+				builder.WriteString("\u00a0")
+			}
+			builder.WriteString(symbol)
+			if isPrefix && i+size == len(affix) && endsWithLetter(symbol) {
+				builder.WriteString("\u00a0")
+			}
+		case r == '%':
+			builder.WriteString(numberFormat.PercentSign)
+		case r == '‰':
+			builder.WriteString(numberFormat.PerMille)
+		case r == '-':
+			builder.WriteString(numberFormat.MinusSign)
+		case r == '+':
+			builder.WriteString(numberFormat.PlusSign)
+		default:
+			builder.WriteRune(r)
+		}
+		i += size
+	}
+
+	return builder.String()
+}
+
+// affixSymbol returns the currency text of a run of ¤ in a pattern: ¤ is the symbol of the currency display,
+// ¤¤ the ISO code, ¤¤¤ the name and ¤¤¤¤¤ the narrow symbol.
+func (nc *numberCurrency) affixSymbol(count int) string {
+	switch {
+	case count == 2:
+		return nc.currency.ID
+	case count == 3 && nc.name.Name != "":
+		return nc.name.Name
+	case count == 5 && nc.name.NarrowSymbol != "":
+		return nc.name.NarrowSymbol
+	case count == 3 || count == 5:
+		return nc.currency.ID
+	default:
+		return nc.symbol
+	}
+}
+
+// roundNumberIncrement rounds a number half away from zero to a multiple of an increment in units of the last
+// fraction digit, e.g. 5 with 2 fraction digits rounds to 0.05.
+func roundNumberIncrement(value *big.Rat, increment, fractionDigits int) *big.Rat {
+	step := new(big.Rat).SetFrac(big.NewInt(int64(increment)), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(fractionDigits)), nil))
+
+	steps, _ := new(big.Int).SetString(new(big.Rat).Quo(value, step).FloatString(0), 10)
+
+	return new(big.Rat).Mul(new(big.Rat).SetInt(steps), step)
+}
+
+// parseDecimal parses a plain decimal number with an optional exponent. The length and exponent are bounded,
+// so the formatted number stays short.
+func parseDecimal(number string) (*big.Rat, error) {
+	number = strings.TrimSpace(number)
+	if len(number) > numberMaxLength {
+		return nil, ErrInvalidNumber
+	}
+
+	match := numberDecimal.FindStringSubmatch(number)
+	if match == nil {
+		return nil, ErrInvalidNumber
+	}
+	if match[1] != "" {
+		if exponent, err := strconv.Atoi(match[1]); err != nil || exponent > numberMaxExponent || exponent < -numberMaxExponent {
+			return nil, ErrInvalidNumber
+		}
+	}
+
+	value, ok := new(big.Rat).SetString(number)
+	if !ok {
+		return nil, ErrInvalidNumber
+	}
+
+	return value, nil
+}
+
+// nativeDigits replaces the ASCII digits of a number with the digits of a numbering system.
+func nativeDigits(number, digits string) string {
+	nativeDigits := []rune(digits)
+	if len(nativeDigits) != 10 || digits == "0123456789" {
+		return number
+	}
+
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return nativeDigits[r-'0']
+		}
+		return r
+	}, number)
+}
+
+// asciiDigits replaces the digits of a numbering system in a number with ASCII digits.
+func asciiDigits(number, digits string) string {
+	nativeDigits := []rune(digits)
+	if len(nativeDigits) != 10 {
+		return number
+	}
+
+	return strings.Map(func(r rune) rune {
+		for i := range nativeDigits {
+			if nativeDigits[i] == r {
+				return rune('0' + i)
+			}
+		}
+		return r
+	}, number)
+}
+
+// numberPrefix returns the first of the non-empty candidates that value starts with.
+func numberPrefix(value string, candidates []string) (string, bool) {
+	for _, candidate := range candidates {
+		if candidate != "" && strings.HasPrefix(value, candidate) {
+			return candidate, true
+		}
+	}
+
+	return "", false
+}
+
+// startsWithLetter checks if a string starts with a letter.
+func startsWithLetter(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsLetter(r)
+}
+
+// endsWithLetter checks if a string ends with a letter.
+func endsWithLetter(s string) bool {
+	r, _ := utf8.DecodeLastRuneInString(s)
+	return unicode.IsLetter(r)
+}
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// testNumberFormats are number formats like the seeded CLDR data of en and de-CH.
+var testNumberFormats = map[string]*models.NumberFormat{
+	"en": {
+		LocaleID: "en", MinimumGroupingDigits: 1, Decimal: ".", Group: ",", PercentSign: "%", PlusSign: "+", MinusSign: "-", PerMille: "‰",
+		DecimalPattern: "#,##0.###", PercentPattern: "#,##0%", CurrencyPattern: "¤#,##0.00", AccountingPattern: "¤#,##0.00;(¤#,##0.00)",
+		NumberingSystem: models.NumberingSystem{ID: "latn", Digits: "0123456789"},
+	},
+	"de-CH": {
+		LocaleID: "de-CH", MinimumGroupingDigits: 1, Decimal: ".", Group: "’", PercentSign: "%", PlusSign: "+", MinusSign: "-", PerMille: "‰",
+		DecimalPattern: "#,##0.###", PercentPattern: "#,##0%", CurrencyPattern: "¤ #,##0.00;¤-#,##0.00", AccountingPattern: "¤ #,##0.00",
+		NumberingSystem: models.NumberingSystem{ID: "latn", Digits: "0123456789"},
+	},
+	"ar": {
+		LocaleID: "ar", MinimumGroupingDigits: 1, Decimal: "٫", Group: "٬", PercentSign: "٪\u061c", PlusSign: "\u061c+", MinusSign: "\u061c-", PerMille: "؉",
+		DecimalPattern: "#,##0.###", PercentPattern: "#,##0%", CurrencyPattern: "\u200f#,##0.00 ¤", AccountingPattern: "\u200f#,##0.00 ¤",
+		NumberingSystem: models.NumberingSystem{ID: "arab", Digits: "٠١٢٣٤٥٦٧٨٩"},
+	},
+}
+
+// testCurrencies are currencies like the seeded CLDR data, with their names in English.
+var testCurrencies = map[string]*numberCurrency{
+	"USD": {
+		currency: models.Currency{ID: "USD", Digits: 2},
+		name:     models.CurrencyName{CurrencyID: "USD", Name: "US Dollar", Symbol: "$", NarrowSymbol: "$"},
+		symbol:   "$",
+	},
+	"CHF": {
+		currency: models.Currency{ID: "CHF", Digits: 2, CashRounding: sql.NullInt64{Int64: 5, Valid: true}},
+		name:     models.CurrencyName{CurrencyID: "CHF", Name: "Swiss Franc", Symbol: "CHF", NarrowSymbol: "CHF"},
+		symbol:   "CHF",
+	},
+	"JPY": {
+		currency: models.Currency{ID: "JPY", Digits: 0},
+		name:     models.CurrencyName{CurrencyID: "JPY", Name: "Japanese Yen", Symbol: "¥", NarrowSymbol: "¥"},
+		symbol:   "¥",
+	},
+}
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		number string
+		want   string
+		err    error
+	}{
+		{number: "1234.5", want: "2469/2"},
+		{number: " -1 ", want: "-1"},
+		{number: ".5", want: "1/2"},
+		{number: "1.2e6", want: "1200000"},
+		{number: "1e100", want: "1" + strings.Repeat("0", 100)},
+		{number: "1e-100", want: "1/1" + strings.Repeat("0", 100)},
+		{number: "1e101", err: ErrInvalidNumber},
+		{number: "1e1000000", err: ErrInvalidNumber},
+		{number: strings.Repeat("1", 101), err: ErrInvalidNumber},
+		{number: "1/2", err: ErrInvalidNumber},
+		{number: "0x10", err: ErrInvalidNumber},
+		{number: "1,5", err: ErrInvalidNumber},
+		{number: "", err: ErrInvalidNumber},
+	}
+
+	for _, test := range tests {
+		t.Run(test.number, func(t *testing.T) {
+			value, err := parseDecimal(test.number)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("parseDecimal(%q) error = %v, want %v", test.number, err, test.err)
+			}
+			if err == nil && value.RatString() != test.want {
+				t.Errorf("parseDecimal(%q) = %s, want %s", test.number, value.RatString(), test.want)
+			}
+		})
+	}
+}
+
+func TestFormatNumber(t *testing.T) {
+	two, zero := 2, 0
+
+	tests := []struct {
+		name     string
+		locale   string
+		number   string
+		currency string
+		options  NumberFormatOptions
+		want     string
+	}{
+		{name: "decimal", locale: "en", number: "1234567.891", want: "1,234,567.891"},
+		{name: "decimal rounded half away from zero", locale: "en", number: "-2.0005", want: "-2.001"},
+		{name: "negative zero", locale: "en", number: "-0.0001", want: "0"},
+		{name: "fraction digits", locale: "en", number: "1.5", options: NumberFormatOptions{MinimumFractionDigits: &two}, want: "1.50"},
+		{name: "exponent", locale: "en", number: "1.5e3", want: "1,500"},
+		{name: "percent", locale: "en", number: "0.256", options: NumberFormatOptions{Style: enums.PERCENT}, want: "26%"},
+		{name: "native digits", locale: "ar", number: "-1234.5", want: "\u061c-١٬٢٣٤٫٥"},
+		{name: "currency", locale: "en", number: "-1234.5", currency: "USD", options: NumberFormatOptions{Style: enums.CURRENCY}, want: "-$1,234.50"},
+		{name: "accounting", locale: "en", number: "-1234.5", currency: "USD", options: NumberFormatOptions{Style: enums.ACCOUNTING}, want: "($1,234.50)"},
+		{name: "currency digits", locale: "en", number: "1234.5", currency: "JPY", options: NumberFormatOptions{Style: enums.CURRENCY}, want: "¥1,235"},
+		{name: "standard rounding", locale: "de-CH", number: "12.34", currency: "CHF", options: NumberFormatOptions{Style: enums.CURRENCY}, want: "CHF 12.34"},
+		{name: "cash rounding", locale: "de-CH", number: "12.34", currency: "CHF", options: NumberFormatOptions{Style: enums.CURRENCY, Cash: true}, want: "CHF 12.35"},
+		{name: "cash rounding down", locale: "de-CH", number: "-12.32", currency: "CHF", options: NumberFormatOptions{Style: enums.CURRENCY, Cash: true}, want: "CHF-12.30"},
+		{name: "cash rounding without fraction digits", locale: "de-CH", number: "12.34", currency: "CHF", options: NumberFormatOptions{Style: enums.CURRENCY, Cash: true, MaximumFractionDigits: &zero}, want: "CHF 12"},
+		{name: "symbol after number", locale: "ar", number: "5", currency: "USD", options: NumberFormatOptions{Style: enums.CURRENCY}, want: "\u200f٥٫٠٠ $"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, err := parseDecimal(test.number)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.options.Style == "" {
+				test.options.Style = enums.DECIMAL
+			}
+
+			var currency *numberCurrency
+			if test.currency != "" {
+				currency = testCurrencies[test.currency]
+			}
+
+			if got := formatNumber(value, testNumberFormats[test.locale], currency, test.options); got != test.want {
+				t.Errorf("formatNumber(%q) = %q, want %q", test.number, got, test.want)
+			}
+		})
+	}
+}
+
+func TestRenderNumberAffix(t *testing.T) {
+	numberFormat := testNumberFormats["en"]
+
+	tests := []struct {
+		affix    string
+		isPrefix bool
+		want     string
+	}{
+		{affix: "¤", isPrefix: true, want: "$"},
+		{affix: "¤¤", isPrefix: true, want: "USD\u00a0"},
+		{affix: " ¤¤", want: " USD"},
+		{affix: "¤¤", want: "\u00a0USD"},
+		{affix: " ¤¤¤", want: " US Dollar"},
+		{affix: "¤¤¤¤¤", isPrefix: true, want: "$"},
+		{affix: "'¤'¤", isPrefix: true, want: "¤$"},
+		{affix: "-", isPrefix: true, want: "-"},
+		{affix: "%", want: "%"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.affix, func(t *testing.T) {
+			if got := renderNumberAffix(test.affix, numberFormat, testCurrencies["USD"], test.isPrefix); got != test.want {
+				t.Errorf("renderNumberAffix(%q) = %q, want %q", test.affix, got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseNumberPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    numberPattern
+	}{
+		{pattern: "#,##0.###", want: numberPattern{negativePrefix: "-", minInteger: 1, maxFraction: 3, primaryGroup: 3, secondaryGroup: 3}},
+		{pattern: "#,##,##0.###", want: numberPattern{negativePrefix: "-", minInteger: 1, maxFraction: 3, primaryGroup: 3, secondaryGroup: 2}},
+		{pattern: "¤#,##0.00;(¤#,##0.00)", want: numberPattern{positivePrefix: "¤", negativePrefix: "(¤", negativeSuffix: ")", minInteger: 1, minFraction: 2, maxFraction: 2, primaryGroup: 3, secondaryGroup: 3}},
+		{pattern: "#,##0 '%;'", want: numberPattern{positiveSuffix: " '%;'", negativePrefix: "-", negativeSuffix: " '%;'", minInteger: 1, primaryGroup: 3, secondaryGroup: 3}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.pattern, func(t *testing.T) {
+			if got := parseNumberPattern(test.pattern); got != test.want {
+				t.Errorf("parseNumberPattern(%q) = %+v, want %+v", test.pattern, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package services
+
+import (
+	"api-i18n/main/src/dto/responses"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// phoneMinLeadingDigits is the number of national digits typed before a format is chosen,
+// as in the libphonenumber as-you-type formatter.
+const phoneMinLeadingDigits = 3
+
+// phoneTemplateDigits is matched against number patterns to find the longest number a format fits.
+const phoneTemplateDigits = "999999999999999"
+
+// phoneTemplatePrefix stands for the national prefix in a template, so its digits are not filled.
+const phoneTemplatePrefix = "\x01"
+
+// phoneCharacterClass matches a character class in a number pattern, e.g. [2-9].
+var phoneCharacterClass = regexp.MustCompile(`\[[^\[\]]*\]`)
+
+// phoneFormatGroup matches a group reference in a number format, e.g. $1.
+var phoneFormatGroup = regexp.MustCompile(`\$(\d)`)
+
+// phonePatterns holds the compiled patterns of the phone metadata by their expression; the metadata doesn't change.
+var phonePatterns sync.Map
+
+// FormatPhoneNumberAsYouType formats a partially typed phone number with the libphonenumber metadata, like the
+// libphonenumber as-you-type formatter: "0612" in NL gives "06 12" and "+1650253" gives "+1 650-253".
+// The number is normalized like the other phone endpoints, so without region it must be international.
+func FormatPhoneNumberAsYouType(number string, region *string) (*responses.PhoneNumberAsYouType, error) {
+	result := &responses.PhoneNumberAsYouType{PhoneNumber: number}
+
+	n, r := preformatNumberAndRegion(number, region)
+	if n == "" {
+		return result, nil
+	}
+
+	allMetadata, err := phoneRegionMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	digits := phonenumbers.NormalizeDigitsOnly(n)
+	international := strings.HasPrefix(strings.TrimSpace(n), "+")
+	prefix := "+"
+	metadata := allMetadata[r]
+	// The number is parsed in the region it is dialled from, so its international prefix is recognized.
+	dialRegion := r
+
+	// A national number starting with the international prefix, e.g. 00 or 011, is an international number.
+	if !international && metadata != nil {
+		if internationalPrefix, err := compilePhonePattern(`^(?:` + metadata.GetInternationalPrefix() + `)`); err == nil {
+			if match := internationalPrefix.FindString(digits); match != "" && match != digits {
+				international = true
+				prefix = match + " "
+				digits = digits[len(match):]
+			}
+		}
+	}
+
+	if international {
+		code, national, ok := splitPhoneCallingCode(digits)
+		if !ok {
+			result.Formatted = prefix + digits
+			return result, nil
+		}
+
+		r = phonenumbers.GetRegionCodeForCountryCode(code)
+		prefix += strconv.Itoa(code) + " "
+		if r == "001" {
+			// Non-geographical entities like +800 share one metadata region, so their number is not formatted.
+			result.Formatted = strings.TrimSpace(prefix + national)
+		} else {
+			result.Formatted = strings.TrimSpace(prefix + formatPhoneNationalAsYouType(allMetadata[r], national, "", true))
+		}
+	} else if metadata == nil {
+		result.Formatted = digits
+	} else {
+		national, nationalPrefix := digits, ""
+		if np := metadata.GetNationalPrefix(); np != "" && strings.HasPrefix(national, np) {
+			national, nationalPrefix = national[len(np):], np
+		}
+		result.Formatted = formatPhoneNationalAsYouType(metadata, national, nationalPrefix, false)
+	}
+
+	// Non-geographical entities (001) and unknown regions (ZZ) have no region.
+	if r != "" && r != "ZZ" && r != "001" {
+		result.Region = &r
+	}
+
+	if parsedNumber, err := phonenumbers.Parse(n, dialRegion); err == nil {
+		result.IsPossible = phonenumbers.IsPossibleNumber(parsedNumber)
+		result.IsValid = phonenumbers.IsValidNumber(parsedNumber)
+		if regionCode := phonenumbers.GetRegionCodeForNumber(parsedNumber); regionCode != "" && regionCode != "ZZ" && regionCode != "001" {
+			result.Region = &regionCode
+		}
+	}
+
+	return result, nil
+}
+
+// compilePhonePattern compiles a pattern of the phone metadata once.
+func compilePhonePattern(expr string) (*regexp.Regexp, error) {
+	if pattern, ok := phonePatterns.Load(expr); ok {
+		return pattern.(*regexp.Regexp), nil
+	}
+
+	pattern, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	phonePatterns.Store(expr, pattern)
+
+	return pattern, nil
+}
+
+// splitPhoneCallingCode splits the country calling code of up to three digits from an international number.
+func splitPhoneCallingCode(digits string) (int, string, bool) {
+	callingCodes := phonenumbers.GetSupportedCallingCodes()
+	for i := 1; i <= 3 && i <= len(digits); i++ {
+		code, err := strconv.Atoi(digits[:i])
+		if err == nil && callingCodes[code] {
+			return code, digits[i:], true
+		}
+	}
+
+	return 0, "", false
+}
+
+// formatPhoneNationalAsYouType formats the typed national significant number with the first format of the region
+// that matches its leading digits and fits its length. A typed national prefix is kept, in the place the
+// national prefix formatting rule of the format puts it. Numbers without a fitting format are not formatted.
+func formatPhoneNationalAsYouType(metadata *phonenumbers.PhoneMetadata, national, nationalPrefix string, international bool) string {
+	unformatted := nationalPrefix + national
+	if len(national) < phoneMinLeadingDigits {
+		return unformatted
+	}
+
+	formats := metadata.GetNumberFormat()
+	if international && len(metadata.GetIntlNumberFormat()) > 0 {
+		formats = metadata.GetIntlNumberFormat()
+	}
+
+	for _, format := range formats {
+		// Alternative patterns can't be turned into a single template.
+		if format.GetFormat() == "NA" || strings.Contains(format.GetPattern(), "|") {
+			continue
+		}
+		if leadingDigits := format.GetLeadingDigitsPattern(); len(leadingDigits) > 0 {
+			pattern, err := compilePhonePattern(`^(?:` + leadingDigits[min(len(national)-phoneMinLeadingDigits, len(leadingDigits)-1)] + `)`)
+			if err != nil || !pattern.MatchString(national) {
+				continue
+			}
+		}
+
+		template, ok := phoneFormatTemplate(format, metadata.GetNationalPrefix(), nationalPrefix, international)
+		if !ok || strings.Count(template, "X") < len(national) {
+			continue
+		}
+
+		return fillPhoneTemplate(template, national)
+	}
+
+	return unformatted
+}
+
+// phoneFormatTemplate returns the template of the longest number a format fits, in which X stands for a digit,
+// e.g. "XXX XXX XXXX". A typed national prefix is added with the formatting rule of the format, or in front
+// when the rule doesn't contain it.
+func phoneFormatTemplate(format *phonenumbers.NumberFormat, prefix, nationalPrefix string, international bool) (string, bool) {
+	// Character classes and fixed digits become any digit, as the typed digits were matched by the leading digits.
+	pattern := phoneCharacterClass.ReplaceAllString(format.GetPattern(), `\d`)
+	pattern = generalizePhonePatternDigits(pattern)
+
+	numberPattern, err := compilePhonePattern(pattern)
+	if err != nil {
+		return "", false
+	}
+	longest := numberPattern.FindString(phoneTemplateDigits)
+	if longest == "" {
+		return "", false
+	}
+
+	numberFormat := format.GetFormat()
+	prefixText := ""
+	if rule := format.GetNationalPrefixFormattingRule(); !international {
+		// The metadata has the national prefix filled into the rule, e.g. "0$1" or "8 ($1)".
+		group := strings.Index(rule, "$1")
+		includesPrefix := prefix != "" && group >= 0 && strings.Contains(rule[:group]+rule[group+2:], prefix)
+		if nationalPrefix != "" && !includesPrefix {
+			prefixText = nationalPrefix + " "
+		}
+		if group >= 0 && (nationalPrefix != "" || !includesPrefix) {
+			if includesPrefix {
+				rule = strings.Replace(rule[:group], prefix, phoneTemplatePrefix, 1) + "$1" + strings.Replace(rule[group+2:], prefix, phoneTemplatePrefix, 1)
+			}
+			numberFormat = strings.Replace(numberFormat, "$1", rule, 1)
+		}
+	}
+	numberFormat = phoneFormatGroup.ReplaceAllString(numberFormat, "$${$1}")
+
+	template := numberPattern.ReplaceAllString(longest, numberFormat)
+	template = strings.ReplaceAll(template, "9", "X")
+	template = strings.ReplaceAll(template, phoneTemplatePrefix, nationalPrefix)
+
+	return prefixText + template, true
+}
+
+// generalizePhonePatternDigits replaces the fixed digits of a number pattern with \d, keeping the digits
+// of quantifiers like {3,4}.
+func generalizePhonePatternDigits(pattern string) string {
+	var builder strings.Builder
+	inQuantifier, escaped := false, false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '{':
+			inQuantifier = true
+		case r == '}':
+			inQuantifier = false
+		case r >= '0' && r <= '9' && !inQuantifier:
+			builder.WriteString(`\d`)
+			continue
+		}
+		builder.WriteRune(r)
+	}
+
+	return builder.String()
+}
+
+// fillPhoneTemplate fills the digits into the template and cuts it after the last digit.
+func fillPhoneTemplate(template, digits string) string {
+	var builder strings.Builder
+	filled := 0
+	for _, r := range template {
+		if filled == len(digits) {
+			break
+		}
+		if r == 'X' {
+			builder.WriteByte(digits[filled])
+			filled++
+			continue
+		}
+		builder.WriteRune(r)
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,86 @@
+package services
+
+import "testing"
+
+func TestFormatPhoneNumberAsYouType(t *testing.T) {
+	tests := []struct {
+		number     string
+		region     string
+		formatted  string
+		wantRegion string
+		isPossible bool
+		isValid    bool
+	}{
+		{number: "06", region: "NL", formatted: "06", wantRegion: "NL"},
+		{number: "0612", region: "NL", formatted: "06 12", wantRegion: "NL"},
+		{number: "0612345678", region: "NL", formatted: "06 12345678", wantRegion: "NL", isPossible: true, isValid: true},
+		{number: "0201234567", region: "NL", formatted: "020 123 4567", wantRegion: "NL", isPossible: true, isValid: true},
+		{number: "650253", region: "US", formatted: "650-253", wantRegion: "US"},
+		{number: "6502530000", region: "US", formatted: "(650) 253-0000", wantRegion: "US", isPossible: true, isValid: true},
+		{number: "+1650253", formatted: "+1 650-253", wantRegion: "US"},
+		{number: "+16502530000", formatted: "+1 650-253-0000", wantRegion: "US", isPossible: true, isValid: true},
+		{number: "00442079460000", region: "NL", formatted: "00 44 20 7946 0000", wantRegion: "GB", isPossible: true, isValid: true},
+		{number: "011442079460000", region: "US", formatted: "011 44 20 7946 0000", wantRegion: "GB", isPossible: true, isValid: true},
+		{number: "0114420", region: "US", formatted: "011 44 20", wantRegion: "GB"},
+		{number: "+442079460000", formatted: "+44 20 7946 0000", wantRegion: "GB", isPossible: true, isValid: true},
+		{number: "89123456789", region: "RU", formatted: "8 (912) 345-67-89", wantRegion: "RU", isPossible: true, isValid: true},
+		{number: "+800123", formatted: "+800 123"},
+		{number: "+999", formatted: "+999"},
+		{number: "", region: "NL", formatted: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.number+"/"+test.region, func(t *testing.T) {
+			region := test.region
+			got, err := FormatPhoneNumberAsYouType(test.number, &region)
+			if err != nil {
+				t.Fatalf("FormatPhoneNumberAsYouType(%q, %q) error = %v", test.number, test.region, err)
+			}
+
+			gotRegion := ""
+			if got.Region != nil {
+				gotRegion = *got.Region
+			}
+			if got.Formatted != test.formatted || gotRegion != test.wantRegion || got.IsPossible != test.isPossible || got.IsValid != test.isValid {
+				t.Errorf("FormatPhoneNumberAsYouType(%q, %q) = (%q, %q, %v, %v), want (%q, %q, %v, %v)", test.number, test.region,
+					got.Formatted, gotRegion, got.IsPossible, got.IsValid, test.formatted, test.wantRegion, test.isPossible, test.isValid)
+			}
+		})
+	}
+}
+
+func TestGeneralizePhonePatternDigits(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{pattern: `(6)(\d{8})`, want: `(\d)(\d{8})`},
+		{pattern: `(\d{2,3})(\d{3})(\d{4})`, want: `(\d{2,3})(\d{3})(\d{4})`},
+		{pattern: `(80)(\d{3,4})`, want: `(\d\d)(\d{3,4})`},
+	}
+
+	for _, test := range tests {
+		if got := generalizePhonePatternDigits(test.pattern); got != test.want {
+			t.Errorf("generalizePhonePatternDigits(%q) = %q, want %q", test.pattern, got, test.want)
+		}
+	}
+}
+
+func TestFillPhoneTemplate(t *testing.T) {
+	tests := []struct {
+		template string
+		digits   string
+		want     string
+	}{
+		{template: "(XXX) XXX-XXXX", digits: "650", want: "(650"},
+		{template: "(XXX) XXX-XXXX", digits: "6502", want: "(650) 2"},
+		{template: "0X XXXXXXXX", digits: "6123", want: "06 123"},
+		{template: "XXX", digits: "", want: ""},
+	}
+
+	for _, test := range tests {
+		if got := fillPhoneTemplate(test.template, test.digits); got != test.want {
+			t.Errorf("fillPhoneTemplate(%q, %q) = %q, want %q", test.template, test.digits, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package services
+
+import (
+	"api-i18n/main/src/dto/responses"
+	"encoding/csv"
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// ErrInvalidPhoneNumberCSV is returned when an uploaded CSV document has no phone number column.
+var ErrInvalidPhoneNumberCSV = errors.New("invalid phone number CSV document")
+
+// phoneNumberCSVColumns are the header names of the number and region columns, compared case-insensitively.
+var (
+	phoneNumberCSVColumns = []string{"phonenumber", "phone_number", "phone", "number"}
+	phoneRegionCSVColumns = []string{"territoryid", "territory_id", "region", "country"}
+)
+
+// phoneNumberCSVHeader is the header of the columns added to every row of a normalized CSV document.
+var phoneNumberCSVHeader = []string{"isValid", "isPossible", "e164", "national", "international", "region", "type", "dedupKey", "duplicate", "error"}
+
+// FormatPhoneNumberBatchItem formats one number of a batch with its own region or the default region.
+// The dedup key is the E.164 form of every possible number; seen holds the keys of the earlier numbers
+// of the batch, so a repeated number is marked as duplicate.
+func FormatPhoneNumberBatchItem(number string, region, defaultRegion *string, localeID string, seen map[string]bool) responses.PhoneNumberBatchItem {
+	item := responses.PhoneNumberBatchItem{PhoneNumber: number, TerritoryID: region}
+	if region == nil || strings.TrimSpace(*region) == "" {
+		region = defaultRegion
+	}
+
+	n, r := preformatNumberAndRegion(number, region)
+	if n == "" {
+		message := "empty phone number"
+		item.Error = &message
+		return item
+	}
+
+	parsedNumber, err := phonenumbers.Parse(n, r)
+	if err == nil {
+		item.Format, err = formatParsedPhoneNumber(parsedNumber, r, localeID)
+	}
+	if err != nil {
+		message := err.Error()
+		item.Error = &message
+		return item
+	}
+
+	if item.Format.IsPossible {
+		key := phonenumbers.Format(parsedNumber, phonenumbers.E164)
+		item.DedupKey = &key
+		item.Duplicate = seen[key]
+		seen[key] = true
+	}
+
+	return item
+}
+
+// PhoneNumberCSV normalizes the phone numbers of a CSV document row by row.
+type PhoneNumberCSV struct {
+	reader        *csv.Reader
+	header        []string
+	numberColumn  int
+	regionColumn  int
+	defaultRegion *string
+	localeID      string
+}
+
+// NewPhoneNumberCSV reads the header of a CSV document, which needs a phoneNumber column and may have
+// a territoryId column. Rows without a region use the default region.
+func NewPhoneNumberCSV(r io.Reader, defaultRegion *string, localeID string) (*PhoneNumberCSV, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.Join(ErrInvalidPhoneNumberCSV, err)
+	}
+
+	document := &PhoneNumberCSV{reader: reader, header: header, numberColumn: -1, regionColumn: -1, defaultRegion: defaultRegion, localeID: localeID}
+	for i, column := range header {
+		// Spreadsheet exports may start with a byte order mark.
+		column = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))
+		if document.numberColumn < 0 && slices.Contains(phoneNumberCSVColumns, column) {
+			document.numberColumn = i
+		} else if document.regionColumn < 0 && slices.Contains(phoneRegionCSVColumns, column) {
+			document.regionColumn = i
+		}
+	}
+	if document.numberColumn < 0 {
+		return nil, ErrInvalidPhoneNumberCSV
+	}
+
+	return document, nil
+}
+
+// Write writes the CSV document with the normalized columns appended to every row, flushing every 100 rows.
+// A row that cannot be read is written with its error. Echoed cells are escaped against formula injection.
+func (p *PhoneNumberCSV) Write(w io.Writer, flush func() error) error {
+	writer := csv.NewWriter(w)
+	header := make([]string, len(p.header), len(p.header)+len(phoneNumberCSVHeader))
+	for i := range p.header {
+		header[i] = escapeCSVFormula(p.header[i])
+	}
+	if err := writer.Write(append(header, phoneNumberCSVHeader...)); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for rows := 1; ; rows++ {
+		record, err := p.reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		var item responses.PhoneNumberBatchItem
+		if err != nil {
+			message := err.Error()
+			item.Error = &message
+		} else {
+			var region *string
+			if p.regionColumn >= 0 && p.regionColumn < len(record) {
+				region = &record[p.regionColumn]
+			}
+			number := ""
+			if p.numberColumn < len(record) {
+				number = record[p.numberColumn]
+			}
+			item = FormatPhoneNumberBatchItem(number, region, p.defaultRegion, p.localeID, seen)
+		}
+
+		// Pad short rows, so the normalized columns stay under their header.
+		for len(record) < len(p.header) {
+			record = append(record, "")
+		}
+		for i := range record {
+			record[i] = escapeCSVFormula(record[i])
+		}
+		if err := writer.Write(append(record, phoneNumberCSVRecord(&item)...)); err != nil {
+			return err
+		}
+
+		if rows%100 == 0 {
+			writer.Flush()
+			if err := writer.Error(); err != nil {
+				return err
+			}
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+
+	return flush()
+}
+
+// phoneNumberCSVRecord returns the normalized columns of a formatted number, in the order of phoneNumberCSVHeader.
+func phoneNumberCSVRecord(item *responses.PhoneNumberBatchItem) []string {
+	value := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+
+	record := make([]string, len(phoneNumberCSVHeader))
+	if format := item.Format; format != nil {
+		record[0] = strconv.FormatBool(format.IsValid)
+		record[1] = strconv.FormatBool(format.IsPossible)
+		record[2] = value(format.E164)
+		record[3] = value(format.National)
+		record[4] = value(format.International)
+		record[5] = value(format.Region)
+		record[6] = value(format.Type)
+	}
+	record[7] = value(item.DedupKey)
+	record[8] = strconv.FormatBool(item.Duplicate)
+	record[9] = value(item.Error)
+
+	return record
+}
+
+// escapeCSVFormula prefixes a cell that a spreadsheet would read as formula with a quote,
+// so the echoed cells of an uploaded document can't inject formulas.
+func escapeCSVFormula(cell string) string {
+	if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+		return "'" + cell
+	}
+
+	return cell
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+)
+
+func TestEscapeCSVFormula(t *testing.T) {
+	tests := []struct {
+		cell string
+		want string
+	}{
+		{cell: "=HYPERLINK(\"http://example.com\")", want: "'=HYPERLINK(\"http://example.com\")"},
+		{cell: "+31612345678", want: "'+31612345678"},
+		{cell: "-1+1", want: "'-1+1"},
+		{cell: "@SUM(A1)", want: "'@SUM(A1)"},
+		{cell: "\t=1", want: "'\t=1"},
+		{cell: "0612345678", want: "0612345678"},
+		{cell: "NL", want: "NL"},
+		{cell: "", want: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.cell, func(t *testing.T) {
+			if got := escapeCSVFormula(test.cell); got != test.want {
+				t.Errorf("escapeCSVFormula(%q) = %q, want %q", test.cell, got, test.want)
+			}
+		})
+	}
+}
+
+func TestFormatPhoneNumberBatchItem(t *testing.T) {
+	defaultRegion := "NL"
+	region := "BE"
+	empty := ""
+
+	tests := []struct {
+		name      string
+		number    string
+		region    *string
+		dedupKey  string
+		duplicate bool
+		err       bool
+	}{
+		{name: "default region", number: "06 12345678", dedupKey: "+31612345678"},
+		{name: "international duplicate", number: "+31 6 12345678", dedupKey: "+31612345678", duplicate: true},
+		{name: "empty region", number: "0612345678", region: &empty, dedupKey: "+31612345678", duplicate: true},
+		{name: "own region", number: "0470 12 34 56", region: &region, dedupKey: "+32470123456"},
+		{name: "impossible", number: "12"},
+		{name: "empty", number: " ", err: true},
+		{name: "not a number", number: "abc", err: true},
+	}
+
+	seen := make(map[string]bool)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			item := FormatPhoneNumberBatchItem(test.number, test.region, &defaultRegion, "en", seen)
+			if (item.Error != nil) != test.err {
+				t.Fatalf("FormatPhoneNumberBatchItem(%q) error = %v, want error %v", test.number, item.Error, test.err)
+			}
+
+			dedupKey := ""
+			if item.DedupKey != nil {
+				dedupKey = *item.DedupKey
+			}
+			if dedupKey != test.dedupKey || item.Duplicate != test.duplicate {
+				t.Errorf("FormatPhoneNumberBatchItem(%q) = (%q, %v), want (%q, %v)", test.number, dedupKey, item.Duplicate, test.dedupKey, test.duplicate)
+			}
+		})
+	}
+}
+
+func TestPhoneNumberCSVWrite(t *testing.T) {
+	input := "\ufeffName,Phone,Country\n" +
+		"Alice,0612345678,\n" +
+		"=cmd|' /C calc'!A0,+32 470 12 34 56,BE\n" +
+		"Carol\n"
+
+	defaultRegion := "NL"
+	document, err := NewPhoneNumberCSV(strings.NewReader(input), &defaultRegion, "en")
+	if err != nil {
+		t.Fatalf("NewPhoneNumberCSV() error = %v", err)
+	}
+
+	var output bytes.Buffer
+	if err := document.Write(&output, func() error { return nil }); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	records, err := csv.NewReader(&output).ReadAll()
+	if err != nil {
+		t.Fatalf("reading the written CSV: %v", err)
+	}
+	if len(records) != 4 {
+		t.Fatalf("Write() wrote %d records, want 4", len(records))
+	}
+
+	header := append([]string{"\ufeffName", "Phone", "Country"}, phoneNumberCSVHeader...)
+	if strings.Join(records[0], ",") != strings.Join(header, ",") {
+		t.Errorf("header = %v, want %v", records[0], header)
+	}
+
+	column := func(record []string, name string) string {
+		for i := range header {
+			if header[i] == name && i < len(record) {
+				return record[i]
+			}
+		}
+		return ""
+	}
+
+	tests := []struct {
+		record   []string
+		name     string
+		phone    string
+		dedupKey string
+		err      bool
+	}{
+		{record: records[1], name: "Alice", phone: "0612345678", dedupKey: "+31612345678"},
+		{record: records[2], name: "'=cmd|' /C calc'!A0", phone: "'+32 470 12 34 56", dedupKey: "+32470123456"},
+		{record: records[3], name: "Carol", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if len(test.record) != len(header) {
+				t.Fatalf("record has %d columns, want %d", len(test.record), len(header))
+			}
+			if got := column(test.record, "\ufeffName"); got != test.name {
+				t.Errorf("Name = %q, want %q", got, test.name)
+			}
+			if got := column(test.record, "Phone"); got != test.phone {
+				t.Errorf("Phone = %q, want %q", got, test.phone)
+			}
+			if got := column(test.record, "dedupKey"); got != test.dedupKey {
+				t.Errorf("dedupKey = %q, want %q", got, test.dedupKey)
+			}
+			if got := column(test.record, "error"); (got != "") != test.err {
+				t.Errorf("error = %q, want error %v", got, test.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package services
+
+import (
+	"api-i18n/main/src/dto/responses"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// phoneExampleTypes are the number types with example numbers, in the order of the metadata.
+var phoneExampleTypes = []phonenumbers.PhoneNumberType{
+	phonenumbers.MOBILE,
+	phonenumbers.FIXED_LINE,
+	phonenumbers.TOLL_FREE,
+	phonenumbers.PREMIUM_RATE,
+	phonenumbers.SHARED_COST,
+	phonenumbers.VOIP,
+	phonenumbers.PERSONAL_NUMBER,
+	phonenumbers.PAGER,
+	phonenumbers.UAN,
+	phonenumbers.VOICEMAIL,
+}
+
+// phoneRegionMetadata returns the libphonenumber metadata by region, loaded once.
+var phoneRegionMetadata = sync.OnceValues(func() (map[string]*phonenumbers.PhoneMetadata, error) {
+	collection, err := phonenumbers.MetadataCollection()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := make(map[string]*phonenumbers.PhoneMetadata, len(collection.GetMetadata()))
+	for _, regionMetadata := range collection.GetMetadata() {
+		metadata[regionMetadata.GetId()] = regionMetadata
+	}
+
+	return metadata, nil
+})
+
+// GetPhoneMetadata returns the phone number metadata of a region for phone inputs: example numbers per type
+// with their input template, the national and international prefix and the possible lengths.
+// The template of the region is the one of a mobile number. Returns nil for unsupported regions.
+func GetPhoneMetadata(region string) (*responses.PhoneMetadata, error) {
+	r := strings.ToUpper(strings.TrimSpace(region))
+
+	allMetadata, err := phoneRegionMetadata()
+	if err != nil {
+		return nil, err
+	}
+	metadata, ok := allMetadata[r]
+	if !ok || r == "001" {
+		return nil, nil
+	}
+
+	phoneMetadata := &responses.PhoneMetadata{
+		Region:          r,
+		Code:            int(metadata.GetCountryCode()),
+		PossibleLengths: phonePossibleLengths(metadata.GetGeneralDesc()),
+		Examples:        make([]responses.PhoneNumberExample, 0, len(phoneExampleTypes)),
+	}
+	if prefix := metadata.GetNationalPrefix(); prefix != "" {
+		phoneMetadata.NationalPrefix = &prefix
+	}
+	if prefix := metadata.GetPreferredInternationalPrefix(); prefix != "" {
+		phoneMetadata.InternationalPrefix = &prefix
+	} else if prefix := metadata.GetInternationalPrefix(); prefix != "" && strings.Trim(prefix, "0123456789") == "" {
+		phoneMetadata.InternationalPrefix = &prefix
+	}
+
+	for _, numberType := range phoneExampleTypes {
+		number := phonenumbers.GetExampleNumberForType(r, numberType)
+		if number == nil {
+			continue
+		}
+
+		national := phonenumbers.Format(number, phonenumbers.NATIONAL)
+		international := phonenumbers.Format(number, phonenumbers.INTERNATIONAL)
+		example := responses.PhoneNumberExample{
+			Type:            phoneNumberTypeToString(numberType),
+			E164:            phonenumbers.Format(number, phonenumbers.E164),
+			National:        national,
+			International:   international,
+			PossibleLengths: phonePossibleLengths(phoneNumberDesc(metadata, numberType)),
+			Template: responses.PhoneNumberTemplate{
+				National:      phoneNumberTemplate(national, metadata.GetNationalPrefix()),
+				International: phoneNumberTemplate(international, "+"+strconv.Itoa(phoneMetadata.Code)),
+			},
+		}
+		if len(example.PossibleLengths) == 0 {
+			example.PossibleLengths = phoneMetadata.PossibleLengths
+		}
+
+		phoneMetadata.Examples = append(phoneMetadata.Examples, example)
+		if phoneMetadata.Template == nil {
+			phoneMetadata.Template = &example.Template
+		}
+	}
+
+	return phoneMetadata, nil
+}
+
+// phoneNumberDesc returns the number description of a number type.
+func phoneNumberDesc(metadata *phonenumbers.PhoneMetadata, numberType phonenumbers.PhoneNumberType) *phonenumbers.PhoneNumberDesc {
+	switch numberType {
+	case phonenumbers.MOBILE:
+		return metadata.GetMobile()
+	case phonenumbers.FIXED_LINE:
+		return metadata.GetFixedLine()
+	case phonenumbers.TOLL_FREE:
+		return metadata.GetTollFree()
+	case phonenumbers.PREMIUM_RATE:
+		return metadata.GetPremiumRate()
+	case phonenumbers.SHARED_COST:
+		return metadata.GetSharedCost()
+	case phonenumbers.VOIP:
+		return metadata.GetVoip()
+	case phonenumbers.PERSONAL_NUMBER:
+		return metadata.GetPersonalNumber()
+	case phonenumbers.PAGER:
+		return metadata.GetPager()
+	case phonenumbers.UAN:
+		return metadata.GetUan()
+	case phonenumbers.VOICEMAIL:
+		return metadata.GetVoicemail()
+	default:
+		return metadata.GetGeneralDesc()
+	}
+}
+
+// phonePossibleLengths returns the possible lengths of the national significant number of a description.
+// A length of -1 in the metadata means the lengths of the general description.
+func phonePossibleLengths(desc *phonenumbers.PhoneNumberDesc) []int {
+	lengths := make([]int, 0, len(desc.GetPossibleLength()))
+	for _, length := range desc.GetPossibleLength() {
+		if length > 0 {
+			lengths = append(lengths, int(length))
+		}
+	}
+	slices.Sort(lengths)
+
+	return lengths
+}
+
+// phoneNumberTemplate replaces the digits of a formatted number with X, keeping a leading prefix,
+// e.g. "+31 6 12345678" with prefix "+31" gives "+31 X XXXXXXXX".
+func phoneNumberTemplate(formatted, prefix string) string {
+	kept := ""
+	if prefix != "" && strings.HasPrefix(formatted, prefix) {
+		kept, formatted = prefix, formatted[len(prefix):]
+	}
+
+	return kept + strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return 'X'
+		}
+		return r
+	}, formatted)
+}
@@ -1,20 +1,38 @@
 package services
 
 import (
+	"api-i18n/main/src/cache"
 	"api-i18n/main/src/dto/responses"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/nyaruka/phonenumbers"
+	"github.com/valkey-io/valkey-go"
 )
 
-// GetTerritoryPhoneCodes retrieves phone codes for all supported territories.
-func GetTerritoryPhoneCodes(localeID string) (*[]responses.PhoneCodeLookup, error) {
+// GetTerritoryPhoneCodes retrieves phone codes for all supported territories, optionally with their phone metadata.
+func GetTerritoryPhoneCodes(localeID string, withMetadata bool) (*[]responses.PhoneCodeLookup, error) {
 	phoneCodes := make([]responses.PhoneCodeLookup, 0)
 
+	if inCache, err := isPhoneCodesLookupInCache(localeID, withMetadata); err != nil {
+		return nil, err
+	} else if inCache {
+		if cachePhoneCodes, err := getPhoneCodesLookupFromCache(localeID, withMetadata); err != nil {
+			return nil, err
+		} else if cachePhoneCodes != nil && len(*cachePhoneCodes) > 0 {
+			return cachePhoneCodes, nil
+		}
+	}
+
 	regions := phonenumbers.GetSupportedRegions()
-	territories, err := GetTerritoriesLookup(localeID, nil, nil)
+	territories, err := GetTerritoriesLookup(localeID, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -40,63 +58,107 @@ func GetTerritoryPhoneCodes(localeID string) (*[]responses.PhoneCodeLookup, erro
 			Territory: region,
 			Name:      territoryName,
 		}
+		if withMetadata {
+			if phoneCode.Metadata, err = GetPhoneMetadata(region); err != nil {
+				return nil, err
+			}
+		}
 		phoneCodes = append(phoneCodes, phoneCode)
 	}
 
-	// Sort by numeric dial code, then by name, then by territory
+	// Sort by numeric dial code, then by name with the collation of the locale, then by territory
+	sort.Slice(phoneCodes, func(i, j int) bool { return phoneCodes[i].Territory < phoneCodes[j].Territory })
+	sortByCollation(localeID, phoneCodes, func(p *responses.PhoneCodeLookup) string { return p.Name })
 	sort.SliceStable(phoneCodes, func(i, j int) bool {
 		ci, _ := strconv.Atoi(strings.TrimPrefix(phoneCodes[i].Code, "+"))
 		cj, _ := strconv.Atoi(strings.TrimPrefix(phoneCodes[j].Code, "+"))
-		if ci == cj {
-			if phoneCodes[i].Name == phoneCodes[j].Name {
-				return phoneCodes[i].Territory < phoneCodes[j].Territory
-			}
-			return phoneCodes[i].Name < phoneCodes[j].Name
-		}
 		return ci < cj
 	})
 
+	_ = setPhoneCodesLookupToCache(localeID, withMetadata, &phoneCodes)
+
 	return &phoneCodes, nil
 }
 
+// GetTerritoryPhoneCodesByCode retrieves all territories sharing a country calling code, e.g. the US, Canada and
+// the Caribbean for +1. The main territory of the code comes first, followed by the others sorted by name
+// with the collation of the locale.
+func GetTerritoryPhoneCodesByCode(localeID string, code int, withMetadata bool) (*[]responses.PhoneCodeLookup, error) {
+	phoneCodes, err := GetTerritoryPhoneCodes(localeID, withMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	dialCode := "+" + strconv.Itoa(code)
+	mainRegion := phonenumbers.GetRegionCodeForCountryCode(code)
+
+	territories := make([]responses.PhoneCodeLookup, 0)
+	for _, phoneCode := range *phoneCodes {
+		if phoneCode.Code != dialCode {
+			continue
+		}
+		if phoneCode.Territory == mainRegion {
+			territories = append([]responses.PhoneCodeLookup{phoneCode}, territories...)
+		} else {
+			territories = append(territories, phoneCode)
+		}
+	}
+
+	return &territories, nil
+}
+
+// ErrInvalidPhoneNumber is returned when a phone number can't be parsed, e.g. a national number without region.
+var ErrInvalidPhoneNumber = errors.New("invalid phone number")
+
 // ValidatePhoneNumber checks if the given phone number is valid for the specified region.
-func ValidatePhoneNumber(number string, region *string) (bool, error) {
+// Without region the number must be international, and is validated for the regions of its country calling code.
+// The regions are additional: every region the number is valid for is returned, e.g. only CA for a Canadian +1 number,
+// while with a region the validity is still for that region only.
+func ValidatePhoneNumber(number string, region *string) (*responses.PhoneNumberValid, error) {
+	phoneNumberValid := &responses.PhoneNumberValid{Regions: make([]string, 0)}
+
 	n, r := preformatNumberAndRegion(number, region)
 	if n == "" {
-		return false, nil
+		return phoneNumberValid, nil
 	}
 
-	// TODO: The phonenumbers library does not support to disable region checking. For now region is required from caller.
 	parsedNumber, err := phonenumbers.Parse(n, r)
 	if err != nil {
-		return false, err
+		return nil, errors.Join(ErrInvalidPhoneNumber, err)
 	}
 
 	// If region is provided, validate for that region; otherwise validate globally.
-	var isValid bool
 	if r != "" {
-		isValid = phonenumbers.IsValidNumberForRegion(parsedNumber, r)
+		phoneNumberValid.IsValid = phonenumbers.IsValidNumberForRegion(parsedNumber, r)
 	} else {
-		isValid = phonenumbers.IsValidNumber(parsedNumber)
+		phoneNumberValid.IsValid = phonenumbers.IsValidNumber(parsedNumber)
 	}
+	phoneNumberValid.Regions = phoneNumberRegions(parsedNumber)
 
-	return isValid, nil
+	return phoneNumberValid, nil
 }
 
 // FormatPhoneNumber formats the given phone number into various formats and provides validation info.
-func FormatPhoneNumber(number string, region *string) (*responses.PhoneNumberFormat, error) {
+// Without region the number must be international, and its region is inferred from the number.
+// Valid numbers also get their carrier, geographic description and time zones, with text in the given locale.
+func FormatPhoneNumber(number string, region *string, localeID string) (*responses.PhoneNumberFormat, error) {
 	n, r := preformatNumberAndRegion(number, region)
 	if n == "" {
 		return nil, nil
 	}
 
-	// TODO: The phonenumbers library does not support to disable region checking. For now region is required from caller.
 	parsedNumber, err := phonenumbers.Parse(n, r)
 	if err != nil {
-		return nil, err
+		return nil, errors.Join(ErrInvalidPhoneNumber, err)
 	}
 
-	var regionCode, rfc3966, e164, national, international, numberType *string
+	return formatParsedPhoneNumber(parsedNumber, r, localeID)
+}
+
+// formatParsedPhoneNumber formats a parsed phone number; the number is validated for the region when given.
+func formatParsedPhoneNumber(parsedNumber *phonenumbers.PhoneNumber, r string, localeID string) (*responses.PhoneNumberFormat, error) {
+	var regionCode, rfc3966, e164, national, international, numberType, carrier, location *string
+	timeZones := make([]string, 0)
 	var countryCode *int
 
 	// If region is provided, validate for that region; otherwise validate globally.
@@ -125,19 +187,43 @@ func FormatPhoneNumber(number string, region *string) (*responses.PhoneNumberFor
 	}
 
 	if isValid {
-		result := phonenumbers.Format(parsedNumber, phonenumbers.E164)
-		e164 = &result
+		e164Format := phonenumbers.Format(parsedNumber, phonenumbers.E164)
+		e164 = &e164Format
+
+		rfc3966Format := phonenumbers.Format(parsedNumber, phonenumbers.RFC3966)
+		rfc3966 = &rfc3966Format
 
-		result = phonenumbers.Format(parsedNumber, phonenumbers.RFC3966)
-		rfc3966 = &result
+		// Carrier and geocoding data are only available in some languages and fall back to English.
+		language := phoneDataLanguage(localeID)
+		if name, err := phonenumbers.GetCarrierForNumber(parsedNumber, language); err != nil {
+			return nil, err
+		} else if name != "" {
+			carrier = &name
+		}
+
+		if description, err := phonenumbers.GetGeocodingForNumber(parsedNumber, language); err != nil {
+			return nil, err
+		} else if description != "" {
+			location = &description
+		}
+
+		zones, err := phonenumbers.GetTimezonesForNumber(parsedNumber)
+		if err != nil {
+			return nil, err
+		}
+		for _, zone := range zones {
+			if zone != phonenumbers.UNKNOWN_TIMEZONE {
+				timeZones = append(timeZones, zone)
+			}
+		}
 	}
 
 	if isPossible {
-		result := phonenumbers.Format(parsedNumber, phonenumbers.NATIONAL)
-		national = &result
+		nationalFormat := phonenumbers.Format(parsedNumber, phonenumbers.NATIONAL)
+		national = &nationalFormat
 
-		result = phonenumbers.Format(parsedNumber, phonenumbers.INTERNATIONAL)
-		international = &result
+		internationalFormat := phonenumbers.Format(parsedNumber, phonenumbers.INTERNATIONAL)
+		international = &internationalFormat
 	}
 
 	phoneNumberFormat := &responses.PhoneNumberFormat{
@@ -150,6 +236,9 @@ func FormatPhoneNumber(number string, region *string) (*responses.PhoneNumberFor
 		Region:        regionCode,
 		Code:          countryCode,
 		Type:          numberType,
+		Carrier:       carrier,
+		Location:      location,
+		TimeZones:     timeZones,
 	}
 
 	return phoneNumberFormat, nil
@@ -178,6 +267,36 @@ func preformatNumberAndRegion(number string, region *string) (string, string) {
 	return n, r
 }
 
+// phoneNumberRegions returns the regions of the country calling code of a number the number is valid for.
+// Non-geographical numbers like +800 have no region.
+func phoneNumberRegions(parsedNumber *phonenumbers.PhoneNumber) []string {
+	regions := make([]string, 0)
+	for _, region := range phonenumbers.GetRegionCodesForCountryCode(int(parsedNumber.GetCountryCode())) {
+		if region != "001" && phonenumbers.IsValidNumberForRegion(parsedNumber, region) {
+			regions = append(regions, region)
+		}
+	}
+
+	return regions
+}
+
+// phoneDataLanguage returns the language of the libphonenumber carrier and geocoding data for a locale,
+// which uses the legacy code iw for Hebrew and zh_Hant for traditional Chinese.
+func phoneDataLanguage(localeID string) string {
+	parts := strings.Split(strings.ReplaceAll(localeID, "_", "-"), "-")
+
+	switch language := strings.ToLower(parts[0]); {
+	case language == "he":
+		return "iw"
+	case language == "zh" && len(parts) > 1 && strings.EqualFold(parts[1], "Hant"):
+		return "zh_Hant"
+	case language == "":
+		return "en"
+	default:
+		return language
+	}
+}
+
 func phoneNumberTypeToString(t phonenumbers.PhoneNumberType) string {
 	switch t {
 	case phonenumbers.FIXED_LINE:
@@ -208,3 +327,69 @@ func phoneNumberTypeToString(t phonenumbers.PhoneNumberType) string {
 		return ""
 	}
 }
+
+// isPhoneCodesLookupInCache checks if the phone codes exists in the cache.
+func isPhoneCodesLookupInCache(localeID string, withMetadata bool) (bool, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Exists().Key(phoneCodeLookupCacheKey(localeID, withMetadata)).Build())
+	if result.Error() != nil {
+		return false, result.Error()
+	}
+
+	value, err := result.ToInt64()
+	if err != nil {
+		return false, err
+	}
+
+	return value == 1, nil
+}
+
+// getPhoneCodesLookupFromCache gets the phone codes from the cache.
+func getPhoneCodesLookupFromCache(localeID string, withMetadata bool) (*[]responses.PhoneCodeLookup, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Get().Key(phoneCodeLookupCacheKey(localeID, withMetadata)).Build())
+	if result.Error() != nil {
+		return nil, result.Error()
+	}
+
+	value, err := result.ToString()
+	if err != nil {
+		return nil, err
+	}
+
+	var phoneCodes []responses.PhoneCodeLookup
+	if err := json.Unmarshal([]byte(value), &phoneCodes); err != nil {
+		return nil, err
+	}
+
+	return &phoneCodes, nil
+}
+
+// setPhoneCodesLookupToCache sets the phone codes to the cache.
+func setPhoneCodesLookupToCache(localeID string, withMetadata bool, phoneCodes *[]responses.PhoneCodeLookup) error {
+	value, err := json.Marshal(phoneCodes)
+	if err != nil {
+		return err
+	}
+
+	expiration := os.Getenv("VALKEY_EXPIRATION")
+	duration, err := time.ParseDuration(expiration)
+	if err != nil {
+		return err
+	}
+
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Set().Key(phoneCodeLookupCacheKey(localeID, withMetadata)).Value(valkey.BinaryString(value)).Ex(duration).Build())
+	if result.Error() != nil {
+		return result.Error()
+	}
+
+	return nil
+}
+
+// phoneCodeLookupCacheKey returns the key for the phone codes cache, which embeds the metadata or not.
+func phoneCodeLookupCacheKey(localeID string, withMetadata bool) string {
+	content := "codes"
+	if withMetadata {
+		content = "metadata"
+	}
+
+	return fmt.Sprintf("phones:lookup:%s:%s", localeID, content)
+}
@@ -0,0 +1,47 @@
+package services
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestValidatePhoneNumber(t *testing.T) {
+	tests := []struct {
+		number  string
+		region  string
+		isValid bool
+		regions []string
+		err     error
+	}{
+		{number: "0612345678", region: "NL", isValid: true, regions: []string{"NL"}},
+		{number: "0612345678", region: "BE", isValid: false, regions: []string{}},
+		{number: "+31612345678", region: "BE", isValid: false, regions: []string{"NL"}},
+		{number: "+31612345678", isValid: true, regions: []string{"NL"}},
+		{number: "31612345678", isValid: true, regions: []string{"NL"}},
+		{number: "+1 613 555 0123", isValid: true, regions: []string{"CA"}},
+		{number: "+800 1234 5678", isValid: true, regions: []string{}},
+		{number: "", isValid: false, regions: []string{}},
+		{number: "0612345678", err: ErrInvalidPhoneNumber},
+		{number: "abc", region: "NL", err: ErrInvalidPhoneNumber},
+	}
+
+	for _, test := range tests {
+		t.Run(test.number+"/"+test.region, func(t *testing.T) {
+			region := test.region
+			got, err := ValidatePhoneNumber(test.number, &region)
+			if test.err != nil {
+				if !errors.Is(err, test.err) {
+					t.Fatalf("ValidatePhoneNumber(%q, %q) error = %v, want %v", test.number, test.region, err, test.err)
+				}
+				return
+			} else if err != nil {
+				t.Fatalf("ValidatePhoneNumber(%q, %q) error = %v", test.number, test.region, err)
+			}
+
+			if got.IsValid != test.isValid || !slices.Equal(got.Regions, test.regions) {
+				t.Errorf("ValidatePhoneNumber(%q, %q) = (%v, %v), want (%v, %v)", test.number, test.region, got.IsValid, got.Regions, test.isValid, test.regions)
+			}
+		})
+	}
+}
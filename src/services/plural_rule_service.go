@@ -0,0 +1,335 @@
+package services
+
+import (
+	"api-i18n/main/src/database"
+	"api-i18n/main/src/dto/responses"
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+	"fmt"
+	"math/big"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// pluralNumber matches a plain decimal number with an optional compact exponent, e.g. 1.50 or 1.2c6.
+var pluralNumber = regexp.MustCompile(`^[-+]?(\d+)(?:\.(\d+))?(?:[ce](\d{1,2}))?$`)
+
+// pluralMaxExponent is the largest compact exponent of a number, like the compact notation of ICU.
+const pluralMaxExponent = 21
+
+// localeParents holds the parent locales in memory; they only change when CLDR is seeded.
+var localeParents struct {
+	sync.Mutex
+	loaded  bool
+	parents map[string]string
+}
+
+// pluralCategoryOrder is the order of the plural categories in CLDR.
+var pluralCategoryOrder = []string{"zero", "one", "two", "few", "many", "other"}
+
+// GetPluralRules method to get the plural rules of a locale, ordered by type and category.
+// Regional locales without their own rules fall back to their language, e.g. de-CH uses de.
+// Returns the locale of the rules, or an empty string when no rules exist.
+func GetPluralRules(localeID string) (string, []models.PluralRule, error) {
+	candidates := localeFallbacks(localeID)
+
+	rules := make([]models.PluralRule, 0)
+	if result := database.Pg.Find(&rules, "locale_id IN ?", candidates); result.Error != nil {
+		return "", nil, result.Error
+	}
+
+	for _, candidate := range candidates {
+		matched := make([]models.PluralRule, 0)
+		for i := range rules {
+			if rules[i].LocaleID == candidate {
+				matched = append(matched, rules[i])
+			}
+		}
+		if len(matched) > 0 {
+			sortPluralRules(matched)
+			return candidate, matched, nil
+		}
+	}
+
+	return "", nil, nil
+}
+
+// EvaluatePluralCategory returns the plural category of a number for the given rules of one type.
+func EvaluatePluralCategory(rules []models.PluralRule, pluralType enums.PluralType, operands PluralOperands) (string, error) {
+	for i := range rules {
+		if rules[i].Type != pluralType || rules[i].Category == "other" || rules[i].Rule == "" {
+			continue
+		}
+
+		matched, err := evaluatePluralCondition(rules[i].Rule, operands)
+		if err != nil {
+			return "", fmt.Errorf("rule %q of %s: %w", rules[i].Rule, rules[i].LocaleID, err)
+		}
+		if matched {
+			return rules[i].Category, nil
+		}
+	}
+
+	return "other", nil
+}
+
+// PluralOperands holds the digits of a number, so its plural operands are exact for any number of digits.
+type PluralOperands struct {
+	integer  string
+	fraction string
+	exponent int
+}
+
+// NewPluralOperands parses a number into its plural operands. Visible fraction digits are kept,
+// so 1 and 1.0 have different operands. A compact exponent is written as c or e, e.g. 1.2c6, and is at most 21.
+// A number is at most numberMaxLength characters.
+func NewPluralOperands(number string) (PluralOperands, error) {
+	number = strings.TrimSpace(number)
+	if len(number) > numberMaxLength {
+		return PluralOperands{}, ErrInvalidNumber
+	}
+
+	match := pluralNumber.FindStringSubmatch(number)
+	if match == nil {
+		return PluralOperands{}, ErrInvalidNumber
+	}
+
+	integer, fraction := match[1], match[2]
+	exponent := 0
+	if match[3] != "" {
+		exponent, _ = strconv.Atoi(match[3])
+	}
+	if exponent > pluralMaxExponent {
+		return PluralOperands{}, ErrInvalidNumber
+	}
+
+	// Shift the decimal point by the exponent.
+	if exponent > 0 {
+		shift := min(exponent, len(fraction))
+		integer += fraction[:shift] + strings.Repeat("0", exponent-shift)
+		fraction = fraction[shift:]
+	}
+
+	return PluralOperands{integer: integer, fraction: fraction, exponent: exponent}, nil
+}
+
+// Values returns the plural operands as numbers. Numbers with many digits lose precision,
+// so plural rules are evaluated on the digits instead.
+func (po PluralOperands) Values() responses.PluralOperands {
+	trimmed := strings.TrimRight(po.fraction, "0")
+	return responses.PluralOperands{
+		N: parsePluralDigits(po.integer + "." + po.fraction),
+		I: parsePluralDigits(po.integer),
+		V: float64(len(po.fraction)),
+		W: float64(len(trimmed)),
+		F: parsePluralDigits(po.fraction),
+		T: parsePluralDigits(trimmed),
+		C: float64(po.exponent),
+		E: float64(po.exponent),
+	}
+}
+
+// operand returns the value of an operand and whether it is an integer. Only n can have a fraction,
+// in which case its value is the integer part.
+func (po PluralOperands) operand(name string) (*big.Int, bool, error) {
+	trimmed := strings.TrimRight(po.fraction, "0")
+
+	switch name {
+	case "n":
+		return parsePluralInteger(po.integer), trimmed == "", nil
+	case "i":
+		return parsePluralInteger(po.integer), true, nil
+	case "v":
+		return big.NewInt(int64(len(po.fraction))), true, nil
+	case "w":
+		return big.NewInt(int64(len(trimmed))), true, nil
+	case "f":
+		return parsePluralInteger(po.fraction), true, nil
+	case "t":
+		return parsePluralInteger(trimmed), true, nil
+	case "c", "e":
+		return big.NewInt(int64(po.exponent)), true, nil
+	default:
+		return nil, false, fmt.Errorf("invalid operand %q", name)
+	}
+}
+
+// evaluatePluralCondition evaluates a CLDR plural condition like "i = 1 and v = 0 or n % 100 = 2..4".
+func evaluatePluralCondition(condition string, operands PluralOperands) (bool, error) {
+	for _, orCondition := range strings.Split(condition, " or ") {
+		matched := true
+		for _, relation := range strings.Split(orCondition, " and ") {
+			ok, err := evaluatePluralRelation(strings.TrimSpace(relation), operands)
+			if err != nil {
+				return false, err
+			}
+			if !ok {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// evaluatePluralRelation evaluates a relation like "n % 10 = 2..4,9" or "v != 0".
+func evaluatePluralRelation(relation string, operands PluralOperands) (bool, error) {
+	negate := false
+	expression, rangeList, found := strings.Cut(relation, "!=")
+	if found {
+		negate = true
+	} else if expression, rangeList, found = strings.Cut(relation, "="); !found {
+		return false, fmt.Errorf("invalid relation %q", relation)
+	}
+
+	value, isInteger, err := evaluatePluralExpression(strings.TrimSpace(expression), operands)
+	if err != nil {
+		return false, err
+	}
+
+	inRange := false
+	for _, item := range strings.Split(rangeList, ",") {
+		low, high, isRange := strings.Cut(strings.TrimSpace(item), "..")
+		lowValue, ok := new(big.Int).SetString(low, 10)
+		if !ok {
+			return false, fmt.Errorf("invalid range %q", item)
+		}
+		highValue := lowValue
+		if isRange {
+			if highValue, ok = new(big.Int).SetString(high, 10); !ok {
+				return false, fmt.Errorf("invalid range %q", item)
+			}
+		}
+
+		// Ranges only contain integers, so 1.5 is not in 1..2.
+		if isInteger && value.Cmp(lowValue) >= 0 && value.Cmp(highValue) <= 0 {
+			inRange = true
+			break
+		}
+	}
+
+	return inRange != negate, nil
+}
+
+// evaluatePluralExpression evaluates an operand with an optional modulus, e.g. "n % 100".
+// Returns whether the value is an integer; the modulus of a fraction is never one either.
+func evaluatePluralExpression(expression string, operands PluralOperands) (*big.Int, bool, error) {
+	operand, modulus, hasModulus := strings.Cut(expression, "%")
+
+	value, isInteger, err := operands.operand(strings.TrimSpace(operand))
+	if err != nil {
+		return nil, false, err
+	}
+
+	if hasModulus {
+		divisor, ok := new(big.Int).SetString(strings.TrimSpace(modulus), 10)
+		if !ok || divisor.Sign() <= 0 {
+			return nil, false, fmt.Errorf("invalid modulus %q", modulus)
+		}
+		value = new(big.Int).Mod(value, divisor)
+	}
+
+	return value, isInteger, nil
+}
+
+// parsePluralDigits parses a string of digits, which may be empty.
+func parsePluralDigits(digits string) float64 {
+	if digits == "" {
+		return 0
+	}
+
+	value, _ := strconv.ParseFloat(digits, 64)
+	return value
+}
+
+// parsePluralInteger parses a string of digits, which may be empty, without losing precision.
+func parsePluralInteger(digits string) *big.Int {
+	value, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return new(big.Int)
+	}
+
+	return value
+}
+
+// sortPluralRules sorts plural rules by type and CLDR category order.
+func sortPluralRules(rules []models.PluralRule) {
+	index := func(category string) int {
+		for i := range pluralCategoryOrder {
+			if pluralCategoryOrder[i] == category {
+				return i
+			}
+		}
+		return len(pluralCategoryOrder)
+	}
+
+	slices.SortFunc(rules, func(a, b models.PluralRule) int {
+		if a.Type != b.Type {
+			return strings.Compare(a.Type.String(), b.Type.String())
+		}
+		return index(a.Category) - index(b.Category)
+	})
+}
+
+// localeFallbacks returns a locale ID followed by its parent locales up to root, e.g. de-CH, de.
+// The parents are those of CLDR parentLocales, e.g. en-150 falls back to en-001 before en;
+// other locales fall back by stripping their last subtag.
+func localeFallbacks(localeID string) []string {
+	return localeParentChain(localeID, getLocaleParents())
+}
+
+// localeParentChain returns a locale ID followed by its parents. An empty parent is root, which ends the chain;
+// locales without known parent fall back by stripping their last subtag.
+func localeParentChain(localeID string, parents map[string]string) []string {
+	candidates := make([]string, 0)
+
+	current := strings.ReplaceAll(localeID, "_", "-")
+	for current != "" && current != "root" && !slices.Contains(candidates, current) {
+		candidates = append(candidates, current)
+
+		parent, ok := parents[current]
+		if !ok {
+			parent = ""
+			if i := strings.LastIndex(current, "-"); i > 0 {
+				parent = current[:i]
+			}
+		}
+		current = parent
+	}
+
+	return candidates
+}
+
+// getLocaleParents returns the parent of every locale with metadata, loading them on first use.
+// Locales that inherit from root have an empty parent. When loading fails, an empty map is returned
+// and loading is retried on the next call.
+func getLocaleParents() map[string]string {
+	localeParents.Lock()
+	defer localeParents.Unlock()
+
+	if localeParents.loaded {
+		return localeParents.parents
+	}
+
+	localeMetadata := make([]models.LocaleMetadata, 0)
+	if result := database.Pg.Select("locale_id", "parent_locale_id").Find(&localeMetadata); result.Error != nil {
+		return make(map[string]string)
+	}
+
+	parents := make(map[string]string, len(localeMetadata))
+	for _, metadata := range localeMetadata {
+		parents[metadata.LocaleID] = metadata.ParentLocaleID.String
+	}
+
+	localeParents.loaded = true
+	localeParents.parents = parents
+
+	return parents
+}
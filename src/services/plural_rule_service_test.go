@@ -0,0 +1,121 @@
+package services
+
+import (
+	"api-i18n/main/src/dto/responses"
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNewPluralOperands(t *testing.T) {
+	tests := []struct {
+		number   string
+		operands responses.PluralOperands
+		err      error
+	}{
+		{number: "1", operands: responses.PluralOperands{N: 1, I: 1}},
+		{number: "1.0", operands: responses.PluralOperands{N: 1, I: 1, V: 1}},
+		{number: "1.50", operands: responses.PluralOperands{N: 1.5, I: 1, V: 2, W: 1, F: 50, T: 5}},
+		{number: "-2.5", operands: responses.PluralOperands{N: 2.5, I: 2, V: 1, W: 1, F: 5, T: 5}},
+		{number: "1.2c6", operands: responses.PluralOperands{N: 1200000, I: 1200000, C: 6, E: 6}},
+		{number: "1.23c1", operands: responses.PluralOperands{N: 12.3, I: 12, V: 1, W: 1, F: 3, T: 3, C: 1, E: 1}},
+		{number: "1c21", operands: responses.PluralOperands{N: 1e21, I: 1e21, C: 21, E: 21}},
+		{number: "1.5" + strings.Repeat("0", 97), operands: responses.PluralOperands{N: 1.5, I: 1, V: 98, W: 1, F: 5e97, T: 5}},
+		{number: strings.Repeat("9", 101), err: ErrInvalidNumber},
+		{number: "1c22", err: ErrInvalidNumber},
+		{number: "1c900000000", err: ErrInvalidNumber},
+		{number: "1e999999999999999999999", err: ErrInvalidNumber},
+		{number: "1,5", err: ErrInvalidNumber},
+		{number: "abc", err: ErrInvalidNumber},
+		{number: "", err: ErrInvalidNumber},
+	}
+
+	for _, test := range tests {
+		t.Run(test.number, func(t *testing.T) {
+			operands, err := NewPluralOperands(test.number)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("NewPluralOperands(%q) error = %v, want %v", test.number, err, test.err)
+			}
+			if values := operands.Values(); err == nil && values != test.operands {
+				t.Errorf("NewPluralOperands(%q) = %+v, want %+v", test.number, values, test.operands)
+			}
+		})
+	}
+}
+
+func TestEvaluatePluralCategory(t *testing.T) {
+	// The cardinal rules of Polish.
+	rules := []models.PluralRule{
+		{LocaleID: "pl", Type: enums.CARDINAL, Category: "one", Rule: "i = 1 and v = 0"},
+		{LocaleID: "pl", Type: enums.CARDINAL, Category: "few", Rule: "v = 0 and i % 10 = 2..4 and i % 100 != 12..14"},
+		{LocaleID: "pl", Type: enums.CARDINAL, Category: "many", Rule: "v = 0 and i != 1 and i % 10 = 0..1 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 12..14"},
+		{LocaleID: "pl", Type: enums.CARDINAL, Category: "other"},
+	}
+
+	tests := []struct {
+		number   string
+		category string
+	}{
+		{number: "1", category: "one"},
+		{number: "1.0", category: "other"},
+		{number: "2", category: "few"},
+		{number: "12", category: "many"},
+		{number: "22", category: "few"},
+		{number: "5", category: "many"},
+		{number: "1.5", category: "other"},
+		{number: "100000000000000000022", category: "few"},
+		{number: "100000000000000000012", category: "many"},
+		{number: strings.Repeat("9", 99) + "2", category: "few"},
+		{number: "2." + strings.Repeat("0", 98), category: "other"},
+		{number: "2c21", category: "many"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.number, func(t *testing.T) {
+			operands, err := NewPluralOperands(test.number)
+			if err != nil {
+				t.Fatal(err)
+			}
+			category, err := EvaluatePluralCategory(rules, enums.CARDINAL, operands)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if category != test.category {
+				t.Errorf("EvaluatePluralCategory(%q) = %q, want %q", test.number, category, test.category)
+			}
+		})
+	}
+}
+
+func TestLocaleParentChain(t *testing.T) {
+	parents := map[string]string{
+		"en":      "",
+		"en-001":  "en",
+		"en-150":  "en-001",
+		"az-Arab": "",
+		"de-CH":   "de",
+	}
+
+	tests := []struct {
+		localeID string
+		want     []string
+	}{
+		{localeID: "en-150", want: []string{"en-150", "en-001", "en"}},
+		{localeID: "en_150", want: []string{"en-150", "en-001", "en"}},
+		{localeID: "az-Arab-IQ", want: []string{"az-Arab-IQ", "az-Arab"}},
+		{localeID: "de-CH", want: []string{"de-CH", "de"}},
+		{localeID: "nl-BE", want: []string{"nl-BE", "nl"}},
+		{localeID: "root", want: []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.localeID, func(t *testing.T) {
+			if got := localeParentChain(test.localeID, parents); !slices.Equal(got, test.want) {
+				t.Errorf("localeParentChain(%q) = %v, want %v", test.localeID, got, test.want)
+			}
+		})
+	}
+}
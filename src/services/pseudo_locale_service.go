@@ -0,0 +1,292 @@
+package services
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// pseudoLocale describes how the values of a pseudo-locale are generated from the source locale.
+type pseudoLocale struct {
+	accents          bool
+	brackets         bool
+	bidi             bool
+	defaultExpansion int
+}
+
+// pseudoLocales are the supported pseudo-locales.
+//
+//	en-XA: accented and expanded text, wrapped in brackets to spot truncation.
+//	ar-XB: text wrapped in right-to-left override marks to test mirrored layouts.
+var pseudoLocales = map[string]pseudoLocale{
+	"en-XA": {accents: true, brackets: true, defaultExpansion: 30},
+	"ar-XB": {bidi: true},
+}
+
+// pseudoAccents maps ASCII letters to accented look-alikes.
+var pseudoAccents = map[rune]rune{
+	'a': 'å', 'b': 'ƀ', 'c': 'ç', 'd': 'ð', 'e': 'é', 'f': 'ƒ', 'g': 'ĝ', 'h': 'ĥ', 'i': 'î', 'j': 'ĵ',
+	'k': 'ķ', 'l': 'ļ', 'm': 'ɱ', 'n': 'ñ', 'o': 'ö', 'p': 'þ', 'q': 'ǫ', 'r': 'ŕ', 's': 'š', 't': 'ţ',
+	'u': 'û', 'v': 'ṽ', 'w': 'ŵ', 'x': 'ẋ', 'y': 'ý', 'z': 'ž',
+	'A': 'Å', 'B': 'Ɓ', 'C': 'Ç', 'D': 'Ð', 'E': 'É', 'F': 'Ƒ', 'G': 'Ĝ', 'H': 'Ĥ', 'I': 'Î', 'J': 'Ĵ',
+	'K': 'Ķ', 'L': 'Ļ', 'M': 'Ṁ', 'N': 'Ñ', 'O': 'Ö', 'P': 'Þ', 'Q': 'Ǫ', 'R': 'Ŕ', 'S': 'Š', 'T': 'Ţ',
+	'U': 'Û', 'V': 'Ṽ', 'W': 'Ŵ', 'X': 'Ẋ', 'Y': 'Ý', 'Z': 'Ž',
+}
+
+// pseudoPadding are the words used to expand pseudo-localized text.
+var pseudoPadding = []string{"one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten"}
+
+// pseudoProtected matches printf placeholders, HTML tags and HTML entities that must stay intact.
+var pseudoProtected = regexp.MustCompile(`%(?:\d+\$)?[-+0#]*(?:\d+|\*)?(?:\.(?:\d+|\*))?[hlLqjzt]*[diouxXeEfFgGaAcspn%@]|</?[a-zA-Z][^<>]*>|<!--.*?-->|&(?:[a-zA-Z]+|#\d+|#x[0-9a-fA-F]+);`)
+
+// IsPseudoLocale checks if the given locale ID is a supported pseudo-locale.
+func IsPseudoLocale(localeID string) bool {
+	_, ok := getPseudoLocale(localeID)
+	return ok
+}
+
+// GetPseudoTranslations method to generate the translations of a pseudo-locale from the source locale of an app.
+// The expansion is the percentage of extra characters added to each value; nil uses the pseudo-locale default.
+func GetPseudoTranslations(appName, sourceLocaleID, localeID string, expansion *int) (*map[string]interface{}, error) {
+	pseudo, ok := getPseudoLocale(localeID)
+	if !ok {
+		return nil, nil
+	}
+	if expansion != nil {
+		pseudo.defaultExpansion = *expansion
+	}
+
+	translations, err := GetTranslationsByLocaleId(appName, sourceLocaleID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]interface{}, len(*translations))
+	for key, value := range *translations {
+		result[key] = pseudo.transformValue(value)
+	}
+
+	return &result, nil
+}
+
+// getPseudoLocale returns the pseudo-locale with the given ID, case-insensitive.
+func getPseudoLocale(localeID string) (pseudoLocale, bool) {
+	for id, pseudo := range pseudoLocales {
+		if strings.EqualFold(id, strings.ReplaceAll(localeID, "_", "-")) {
+			return pseudo, true
+		}
+	}
+
+	return pseudoLocale{}, false
+}
+
+// transformValue pseudo-localizes a translation value: strings are transformed,
+// JSON objects and arrays are walked recursively and other values are returned as-is.
+func (p pseudoLocale) transformValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return p.transform(v)
+	case json.RawMessage:
+		var decoded interface{}
+		if err := json.Unmarshal(v, &decoded); err != nil {
+			return v
+		}
+		return p.transformValue(decoded)
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = p.transformValue(item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = p.transformValue(item)
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+// transform pseudo-localizes a single message while keeping placeholders and markup intact.
+func (p pseudoLocale) transform(message string) string {
+	if message == "" {
+		return message
+	}
+
+	transformed, length := p.transformMessage(message)
+
+	var builder strings.Builder
+	if p.bidi {
+		builder.WriteString("\u200f")
+	}
+	if p.brackets {
+		builder.WriteString("[")
+	}
+	builder.WriteString(transformed)
+	if padding := pseudoPaddingFor(length * p.defaultExpansion / 100); padding != "" {
+		builder.WriteString(" ")
+		builder.WriteString(p.transformText(padding))
+	}
+	if p.brackets {
+		builder.WriteString("]")
+	}
+	if p.bidi {
+		builder.WriteString("\u200f")
+	}
+
+	return builder.String()
+}
+
+// transformMessage transforms the text parts of an ICU message and returns it with the number of
+// transformed characters. Simple arguments ({name}) are kept, the sub-messages of plural, select
+// and selectordinal arguments are transformed recursively and other arguments are kept verbatim.
+func (p pseudoLocale) transformMessage(message string) (string, int) {
+	var builder strings.Builder
+	length := 0
+
+	for len(message) > 0 {
+		open := strings.IndexByte(message, '{')
+		if open < 0 {
+			text, n := p.transformPlain(message)
+			builder.WriteString(text)
+			length += n
+			break
+		}
+
+		text, n := p.transformPlain(message[:open])
+		builder.WriteString(text)
+		length += n
+
+		end := matchingBrace(message, open)
+		if end < 0 {
+			builder.WriteString(message[open:])
+			break
+		}
+
+		argument, n := p.transformArgument(message[open+1 : end])
+		builder.WriteString("{" + argument + "}")
+		length += n
+		message = message[end+1:]
+	}
+
+	return builder.String(), length
+}
+
+// transformArgument transforms the content of an ICU argument without its outer braces.
+func (p pseudoLocale) transformArgument(argument string) (string, int) {
+	parts := strings.SplitN(argument, ",", 3)
+	if len(parts) < 3 {
+		return argument, 0
+	}
+
+	switch strings.TrimSpace(parts[1]) {
+	case "plural", "select", "selectordinal":
+	default:
+		return argument, 0
+	}
+
+	var builder strings.Builder
+	builder.WriteString(parts[0] + "," + parts[1] + ",")
+
+	// The options are a sequence of "selector {sub-message}".
+	options := parts[2]
+	length := 0
+	for len(options) > 0 {
+		open := strings.IndexByte(options, '{')
+		if open < 0 {
+			builder.WriteString(options)
+			break
+		}
+		end := matchingBrace(options, open)
+		if end < 0 {
+			builder.WriteString(options)
+			break
+		}
+
+		subMessage, n := p.transformMessage(options[open+1 : end])
+		builder.WriteString(options[:open] + "{" + subMessage + "}")
+		if n > length {
+			length = n
+		}
+		options = options[end+1:]
+	}
+
+	return builder.String(), length
+}
+
+// transformPlain transforms text that contains no ICU arguments, keeping printf placeholders and HTML intact.
+func (p pseudoLocale) transformPlain(text string) (string, int) {
+	var builder strings.Builder
+	length := 0
+
+	last := 0
+	for _, loc := range pseudoProtected.FindAllStringIndex(text, -1) {
+		builder.WriteString(p.transformText(text[last:loc[0]]))
+		length += utf8.RuneCountInString(text[last:loc[0]])
+		builder.WriteString(text[loc[0]:loc[1]])
+		last = loc[1]
+	}
+	builder.WriteString(p.transformText(text[last:]))
+	length += utf8.RuneCountInString(text[last:])
+
+	return builder.String(), length
+}
+
+// transformText transforms plain text without any placeholders.
+func (p pseudoLocale) transformText(text string) string {
+	if text == "" {
+		return text
+	}
+
+	if p.accents {
+		text = strings.Map(func(r rune) rune {
+			if accent, ok := pseudoAccents[r]; ok {
+				return accent
+			}
+			return r
+		}, text)
+	}
+	if p.bidi && strings.TrimSpace(text) != "" {
+		text = "\u202e" + text + "\u202c"
+	}
+
+	return text
+}
+
+// pseudoPaddingFor returns padding words of at least the given number of characters.
+func pseudoPaddingFor(length int) string {
+	if length <= 0 {
+		return ""
+	}
+
+	words := make([]string, 0)
+	total := 0
+	for i := 0; total < length; i++ {
+		word := pseudoPadding[i%len(pseudoPadding)]
+		words = append(words, word)
+		total += len(word) + 1
+	}
+
+	return strings.Join(words, " ")
+}
+
+// matchingBrace returns the index of the brace that closes the brace at position open, or -1.
+func matchingBrace(s string, open int) int {
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
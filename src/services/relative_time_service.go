@@ -0,0 +1,121 @@
+package services
+
+import (
+	"api-i18n/main/src/database"
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// ErrRelativeTimeFormatNotFound is returned when a locale has no relative time data for a unit.
+var ErrRelativeTimeFormatNotFound = errors.New("relative time format not found")
+
+// GetRelativeTimeFormat method to get the relative time format of a locale for a unit and width.
+// Locales without data fall back to their parent, e.g. de-CH uses de; missing widths fall back to long.
+func GetRelativeTimeFormat(localeID, unit string, width enums.FormatWidth) (*models.RelativeTimeFormat, error) {
+	relativeTimeFormats := make([]models.RelativeTimeFormat, 0)
+	candidates := localeFallbacks(localeID)
+	widths := []enums.FormatWidth{width, enums.WIDTH_LONG}
+
+	if result := database.Pg.Find(&relativeTimeFormats, "locale_id IN ? AND unit = ? AND width IN ?", candidates, unit, widths); result.Error != nil {
+		return nil, result.Error
+	}
+
+	for _, candidate := range candidates {
+		for _, w := range widths {
+			for i := range relativeTimeFormats {
+				if relativeTimeFormats[i].LocaleID == candidate && relativeTimeFormats[i].Width == w {
+					return &relativeTimeFormats[i], nil
+				}
+			}
+		}
+	}
+
+	return nil, ErrRelativeTimeFormatNotFound
+}
+
+// FormatRelativeTime method to format a relative duration like -3 days for a locale, e.g. "3 days ago".
+// The value is a plain decimal without exponent. With numeric false, named offsets like "yesterday" are used
+// when the locale has them. Returns the formatted duration and the relative time format that was used.
+func FormatRelativeTime(localeID, value, unit string, width enums.FormatWidth, numeric bool) (string, *models.RelativeTimeFormat, error) {
+	value = strings.TrimSpace(value)
+	if !isRelativeTimeValue(value) {
+		return "", nil, ErrInvalidNumber
+	}
+
+	relativeTimeFormat, err := GetRelativeTimeFormat(localeID, unit, width)
+	if err != nil {
+		return "", nil, err
+	}
+
+	_, rules, err := GetPluralRules(relativeTimeFormat.LocaleID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Without number format the number is not localized.
+	numberFormat, err := GetNumberFormat(relativeTimeFormat.LocaleID, nil)
+	if err != nil {
+		numberFormat = nil
+	}
+
+	formatted, err := formatRelativeTime(relativeTimeFormat, rules, numberFormat, value, numeric)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return formatted, relativeTimeFormat, nil
+}
+
+// isRelativeTimeValue checks if a value is a plain decimal like -3 or 1.5. Exponents are not allowed,
+// so the number to format stays short.
+func isRelativeTimeValue(value string) bool {
+	match := pluralNumber.FindStringSubmatch(value)
+	return match != nil && match[3] == "" && len(value) <= numberMaxLength
+}
+
+// formatRelativeTime formats a relative duration with a relative time format, the cardinal plural rules
+// of its locale and an optional number format.
+func formatRelativeTime(relativeTimeFormat *models.RelativeTimeFormat, rules []models.PluralRule, numberFormat *models.NumberFormat, value string, numeric bool) (string, error) {
+	number, _ := new(big.Rat).SetString(value)
+	if !numeric && number.IsInt() {
+		if named, ok := relativeTimeFormat.Relative[number.Num().String()]; ok {
+			return named, nil
+		}
+	}
+
+	absolute := strings.TrimLeft(value, "+-")
+	patterns := relativeTimeFormat.Future
+	if strings.HasPrefix(value, "-") {
+		patterns = relativeTimeFormat.Past
+	}
+
+	category := "other"
+	if operands, err := NewPluralOperands(absolute); err == nil && len(rules) > 0 {
+		if category, err = EvaluatePluralCategory(rules, enums.CARDINAL, operands); err != nil {
+			return "", err
+		}
+	}
+
+	pattern, ok := patterns[category]
+	if !ok {
+		pattern = patterns["other"]
+	}
+
+	// Keep the visible fraction digits, so the number matches its plural category.
+	formatted := absolute
+	if numberFormat != nil {
+		_, fraction, _ := strings.Cut(absolute, ".")
+		fractionDigits := len(fraction)
+		formatted = formatNumber(new(big.Rat).Abs(number), numberFormat, nil, NumberFormatOptions{
+			Style:                 enums.DECIMAL,
+			CurrencyDisplay:       enums.SYMBOL,
+			MinimumFractionDigits: &fractionDigits,
+			MaximumFractionDigits: &fractionDigits,
+		})
+	}
+
+	return strings.ReplaceAll(pattern, "{0}", formatted), nil
+}
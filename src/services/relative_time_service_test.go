@@ -0,0 +1,82 @@
+package services
+
+import (
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+	"strings"
+	"testing"
+)
+
+func TestIsRelativeTimeValue(t *testing.T) {
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{value: "3", want: true},
+		{value: "-3", want: true},
+		{value: "+1.5", want: true},
+		{value: "1e3", want: false},
+		{value: "1c6", want: false},
+		{value: "1e1000000", want: false},
+		{value: strings.Repeat("1", 101), want: false},
+		{value: "1/2", want: false},
+		{value: "", want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			if got := isRelativeTimeValue(test.value); got != test.want {
+				t.Errorf("isRelativeTimeValue(%q) = %v, want %v", test.value, got, test.want)
+			}
+		})
+	}
+}
+
+func TestFormatRelativeTime(t *testing.T) {
+	relativeTimeFormat := &models.RelativeTimeFormat{
+		LocaleID: "en",
+		Unit:     "day",
+		Width:    enums.WIDTH_LONG,
+		Relative: map[string]string{"-1": "yesterday", "0": "today", "1": "tomorrow"},
+		Future:   map[string]string{"one": "in {0} day", "other": "in {0} days"},
+		Past:     map[string]string{"one": "{0} day ago", "other": "{0} days ago"},
+	}
+	rules := []models.PluralRule{
+		{LocaleID: "en", Type: enums.CARDINAL, Category: "one", Rule: "i = 1 and v = 0"},
+		{LocaleID: "en", Type: enums.CARDINAL, Category: "other"},
+	}
+
+	tests := []struct {
+		value        string
+		numeric      bool
+		numberFormat *models.NumberFormat
+		want         string
+	}{
+		{value: "-1", want: "yesterday"},
+		{value: "-1", numeric: true, want: "1 day ago"},
+		{value: "1", numeric: true, want: "in 1 day"},
+		{value: "1.0", numeric: true, want: "in 1.0 days"},
+		{value: "-3", want: "3 days ago"},
+		{value: "+1", want: "tomorrow"},
+		{value: "2", want: "in 2 days"},
+		{value: "1234", want: "in 1,234 days"},
+		{value: "1234", numberFormat: testNumberFormats["ar"], want: "in ١٬٢٣٤ days"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			numberFormat := test.numberFormat
+			if numberFormat == nil {
+				numberFormat = testNumberFormats["en"]
+			}
+
+			got, err := formatRelativeTime(relativeTimeFormat, rules, numberFormat, test.value, test.numeric)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Errorf("formatRelativeTime(%q) = %q, want %q", test.value, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"api-i18n/main/src/database"
+	"api-i18n/main/src/dto/requests"
+	"api-i18n/main/src/models"
+	"api-i18n/main/src/storage"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+// ErrInvalidScreenshot is returned when an uploaded screenshot is not a supported image
+// or its region lies outside the image.
+var ErrInvalidScreenshot = errors.New("invalid screenshot")
+
+// GetKeyScreenshots method to get the screenshots of a key.
+func GetKeyScreenshots(keyID uint) (*[]models.KeyScreenshot, error) {
+	screenshots := make([]models.KeyScreenshot, 0)
+
+	if result := database.Pg.Order("id").Find(&screenshots, "key_id = ?", keyID); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &screenshots, nil
+}
+
+// GetKeyScreenshotByID method to get a screenshot of a key by ID.
+func GetKeyScreenshotByID(keyID, screenshotID uint) (*models.KeyScreenshot, error) {
+	screenshot := &models.KeyScreenshot{}
+
+	if result := database.Pg.Find(screenshot, "key_id = ? AND id = ?", keyID, screenshotID); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return screenshot, nil
+}
+
+// CreateKeyScreenshot method to store a screenshot and attach it to a key.
+// Supported image formats are PNG, JPEG and GIF.
+func CreateKeyScreenshot(keyID uint, fileName string, data []byte, screenshotDto requests.CreateKeyScreenshot) (*models.KeyScreenshot, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Join(ErrInvalidScreenshot, err)
+	}
+
+	screenshot := &models.KeyScreenshot{
+		KeyID:       keyID,
+		FileName:    fileName,
+		ContentType: "image/" + format,
+		Size:        int64(len(data)),
+		Width:       config.Width,
+		Height:      config.Height,
+	}
+
+	if screenshotDto.HasRegion() {
+		if *screenshotDto.RegionX+*screenshotDto.RegionWidth > config.Width || *screenshotDto.RegionY+*screenshotDto.RegionHeight > config.Height {
+			return nil, errors.Join(ErrInvalidScreenshot, errors.New("region lies outside the image"))
+		}
+
+		screenshot.RegionX = sql.Null[int]{V: *screenshotDto.RegionX, Valid: true}
+		screenshot.RegionY = sql.Null[int]{V: *screenshotDto.RegionY, Valid: true}
+		screenshot.RegionWidth = sql.Null[int]{V: *screenshotDto.RegionWidth, Valid: true}
+		screenshot.RegionHeight = sql.Null[int]{V: *screenshotDto.RegionHeight, Valid: true}
+	}
+
+	name := make([]byte, 16)
+	if _, err := rand.Read(name); err != nil {
+		return nil, err
+	}
+	screenshot.Path = fmt.Sprintf("screenshots/%d/%s.%s", keyID, hex.EncodeToString(name), format)
+
+	if err := storage.Files.Put(context.Background(), screenshot.Path, bytes.NewReader(data), screenshot.Size, screenshot.ContentType); err != nil {
+		return nil, err
+	}
+
+	if err := database.Pg.Create(screenshot).Error; err != nil {
+		_ = storage.Files.Delete(context.Background(), screenshot.Path)
+		return nil, err
+	}
+
+	return screenshot, nil
+}
+
+// DeleteKeyScreenshot method to delete a screenshot and its stored file.
+func DeleteKeyScreenshot(screenshot *models.KeyScreenshot) error {
+	if err := database.Pg.Delete(&models.KeyScreenshot{}, screenshot.ID).Error; err != nil {
+		return err
+	}
+
+	return storage.Files.Delete(context.Background(), screenshot.Path)
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"api-i18n/main/src/cache"
+	"api-i18n/main/src/database"
+	"api-i18n/main/src/models"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+	"gorm.io/gorm"
+)
+
+// GetScriptsLookup method to get scripts lookup by locale ID and optional name filter.
+// Every script has its writing direction and the languages that use it.
+func GetScriptsLookup(localeID string, name *string) (*[]models.ScriptName, error) {
+	scripts := make([]models.ScriptName, 0)
+
+	if inCache, err := isScriptsLookupInCache(localeID); err != nil {
+		return nil, err
+	} else if inCache {
+		if cacheScripts, err := getScriptsLookupFromCache(localeID); err != nil {
+			return nil, err
+		} else if cacheScripts != nil && len(*cacheScripts) > 0 {
+			scripts = *cacheScripts
+		}
+	}
+
+	if len(scripts) == 0 {
+		query := database.Pg.Model(&models.ScriptName{}).
+			Preload("Script").
+			Preload("Languages", func(db *gorm.DB) *gorm.DB {
+				return db.Order("script_languages.secondary, script_languages.language_id")
+			}).
+			Where("script_names.script_id NOT LIKE ?", "%-alt-%")
+
+		if result := query.Find(&scripts, "locale_id = ?", localeID); result.Error != nil {
+			return nil, result.Error
+		}
+
+		_ = setScriptsLookupToCache(localeID, &scripts)
+	}
+
+	// If a name filter is provided, match it ignoring case and accents, names starting with it first
+	if name != nil {
+		scripts = filterByName(localeID, scripts, *name, func(x *models.ScriptName) string { return x.Name })
+	}
+
+	return &scripts, nil
+}
+
+// isScriptsLookupInCache checks if the scripts exists in the cache.
+func isScriptsLookupInCache(localeID string) (bool, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Exists().Key(scriptLookupCacheKey(localeID)).Build())
+	if result.Error() != nil {
+		return false, result.Error()
+	}
+
+	value, err := result.ToInt64()
+	if err != nil {
+		return false, err
+	}
+
+	return value == 1, nil
+}
+
+// getScriptsLookupFromCache gets the scripts from the cache.
+func getScriptsLookupFromCache(localeID string) (*[]models.ScriptName, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Get().Key(scriptLookupCacheKey(localeID)).Build())
+	if result.Error() != nil {
+		return nil, result.Error()
+	}
+
+	value, err := result.ToString()
+	if err != nil {
+		return nil, err
+	}
+
+	var scripts []models.ScriptName
+	if err := json.Unmarshal([]byte(value), &scripts); err != nil {
+		return nil, err
+	}
+
+	return &scripts, nil
+}
+
+// setScriptsLookupToCache sets the scripts to the cache.
+func setScriptsLookupToCache(localeID string, scripts *[]models.ScriptName) error {
+	value, err := json.Marshal(scripts)
+	if err != nil {
+		return err
+	}
+
+	expiration := os.Getenv("VALKEY_EXPIRATION")
+	duration, err := time.ParseDuration(expiration)
+	if err != nil {
+		return err
+	}
+
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Set().Key(scriptLookupCacheKey(localeID)).Value(valkey.BinaryString(value)).Ex(duration).Build())
+	if result.Error() != nil {
+		return result.Error()
+	}
+
+	return nil
+}
+
+// scriptLookupCacheKey returns the key for the scripts cache.
+func scriptLookupCacheKey(localeID string) string {
+	return fmt.Sprintf("scripts:lookup:%s", localeID)
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"api-i18n/main/src/cache"
+	"api-i18n/main/src/database"
+	"api-i18n/main/src/models"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+)
+
+// GetSubdivisionsLookup method to get the subdivisions lookup of a territory by locale ID and optional name filter.
+// Only a few locales have subdivision names, so names fall back to the parent locale and then to English.
+func GetSubdivisionsLookup(localeID, territoryID string, name *string) (*[]models.SubdivisionName, error) {
+	subdivisions := make([]models.SubdivisionName, 0)
+
+	if inCache, err := isSubdivisionsLookupInCache(localeID, territoryID); err != nil {
+		return nil, err
+	} else if inCache {
+		if cacheSubdivisions, err := getSubdivisionsLookupFromCache(localeID, territoryID); err != nil {
+			return nil, err
+		} else if cacheSubdivisions != nil && len(*cacheSubdivisions) > 0 {
+			subdivisions = *cacheSubdivisions
+		}
+	}
+
+	if len(subdivisions) == 0 {
+		candidates := localeFallbacks(localeID)
+		if !slices.Contains(candidates, "en") {
+			candidates = append(candidates, "en")
+		}
+
+		names := make([]models.SubdivisionName, 0)
+		if result := database.Pg.Model(&models.SubdivisionName{}).
+			Joins("JOIN subdivisions ON subdivision_names.subdivision_id = subdivisions.id").
+			Where("subdivisions.territory_id = ? AND subdivision_names.locale_id IN ?", territoryID, candidates).
+			Find(&names); result.Error != nil {
+			return nil, result.Error
+		}
+
+		// Keep the name of the first candidate locale for every subdivision.
+		best := make(map[string]int, len(names))
+		for i := range names {
+			j, ok := best[names[i].SubdivisionID]
+			if !ok || slices.Index(candidates, names[i].LocaleID) < slices.Index(candidates, names[j].LocaleID) {
+				best[names[i].SubdivisionID] = i
+			}
+		}
+		for _, i := range best {
+			subdivisions = append(subdivisions, names[i])
+		}
+		sortByCollation(localeID, subdivisions, func(s *models.SubdivisionName) string { return s.Name })
+
+		_ = setSubdivisionsLookupToCache(localeID, territoryID, &subdivisions)
+	}
+
+	// If a name filter is provided, match it ignoring case and accents, names starting with it first
+	if name != nil {
+		subdivisions = filterByName(localeID, subdivisions, *name, func(x *models.SubdivisionName) string { return x.Name })
+	}
+
+	return &subdivisions, nil
+}
+
+// isSubdivisionsLookupInCache checks if the subdivisions exists in the cache.
+func isSubdivisionsLookupInCache(localeID, territoryID string) (bool, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Exists().Key(subdivisionLookupCacheKey(localeID, territoryID)).Build())
+	if result.Error() != nil {
+		return false, result.Error()
+	}
+
+	value, err := result.ToInt64()
+	if err != nil {
+		return false, err
+	}
+
+	return value == 1, nil
+}
+
+// getSubdivisionsLookupFromCache gets the subdivisions from the cache.
+func getSubdivisionsLookupFromCache(localeID, territoryID string) (*[]models.SubdivisionName, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Get().Key(subdivisionLookupCacheKey(localeID, territoryID)).Build())
+	if result.Error() != nil {
+		return nil, result.Error()
+	}
+
+	value, err := result.ToString()
+	if err != nil {
+		return nil, err
+	}
+
+	var subdivisions []models.SubdivisionName
+	if err := json.Unmarshal([]byte(value), &subdivisions); err != nil {
+		return nil, err
+	}
+
+	return &subdivisions, nil
+}
+
+// setSubdivisionsLookupToCache sets the subdivisions to the cache.
+func setSubdivisionsLookupToCache(localeID, territoryID string, subdivisions *[]models.SubdivisionName) error {
+	value, err := json.Marshal(subdivisions)
+	if err != nil {
+		return err
+	}
+
+	expiration := os.Getenv("VALKEY_EXPIRATION")
+	duration, err := time.ParseDuration(expiration)
+	if err != nil {
+		return err
+	}
+
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Set().Key(subdivisionLookupCacheKey(localeID, territoryID)).Value(valkey.BinaryString(value)).Ex(duration).Build())
+	if result.Error() != nil {
+		return result.Error()
+	}
+
+	return nil
+}
+
+// subdivisionLookupCacheKey returns the key for the subdivisions cache.
+func subdivisionLookupCacheKey(localeID, territoryID string) string {
+	return fmt.Sprintf("subdivisions:lookup:%s:%s", localeID, territoryID)
+}
@@ -9,20 +9,24 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/valkey-io/valkey-go"
+	"gorm.io/gorm"
 )
 
-// GetTerritoriesLookup method to get territories lookup by locale ID, type and optional name filter.
-func GetTerritoriesLookup(localeID string, t *enums.TerritoryType, name *string) (*[]models.TerritoryName, error) {
+// GetTerritoriesLookup method to get territories lookup by locale ID, type, optional containing region and optional name filter.
+// The territories are sorted by name with the collation of the locale.
+// The containing region matches all territories it contains directly or through its subregions, e.g. 150 or EU.
+func GetTerritoriesLookup(localeID string, t *enums.TerritoryType, containedIn *string, name *string) (*[]models.TerritoryName, error) {
 	territories := make([]models.TerritoryName, 0)
 
-	if inCache, err := isTerritoriesLookupInCache(localeID, t); err != nil {
+	if inCache, err := isTerritoriesLookupInCache(localeID, t, containedIn); err != nil {
 		return nil, err
 	} else if inCache {
-		if cacheTerritories, err := getTerritoriesLookupFromCache(localeID, t); err != nil {
+		if cacheTerritories, err := getTerritoriesLookupFromCache(localeID, t, containedIn); err != nil {
 			return nil, err
 		} else if cacheTerritories != nil && len(*cacheTerritories) > 0 {
 			territories = *cacheTerritories
@@ -32,40 +36,204 @@ func GetTerritoriesLookup(localeID string, t *enums.TerritoryType, name *string)
 	if len(territories) == 0 {
 		query := database.Pg.Model(&models.TerritoryName{}).
 			Preload("Territory").
+			Preload("Code").
 			Joins("JOIN territories ON territory_names.territory_id = territories.id")
 
 		if t != nil {
 			query = query.Where("territories.type = ?", *t)
 		}
 
+		if containedIn != nil {
+			query = query.Where(`territories.id IN (WITH RECURSIVE contained(id) AS (
+				SELECT child_id FROM territory_containments WHERE parent_id = ?
+				UNION
+				SELECT territory_containments.child_id FROM territory_containments JOIN contained ON territory_containments.parent_id = contained.id
+			) SELECT id FROM contained)`, *containedIn)
+		}
+
 		if result := query.Find(&territories, "locale_id = ?", localeID); result.Error != nil {
 			return nil, result.Error
 		}
+		sortByCollation(localeID, territories, func(t *models.TerritoryName) string { return t.Name })
 
-		_ = setTerritoriesLookupToCache(localeID, t, &territories)
+		_ = setTerritoriesLookupToCache(localeID, t, containedIn, &territories)
 	}
 
-	// If a name filter is provided, perform case-insensitive substring match on the list
+	// If a name filter is provided, match it ignoring case and accents, names starting with it first
 	if name != nil {
-		target := strings.TrimSpace(*name)
-		if target != "" {
-			lowerTarget := strings.ToLower(target)
-			filtered := make([]models.TerritoryName, 0, len(territories))
-			for i := range territories {
-				if strings.Contains(strings.ToLower(territories[i].Name), lowerTarget) {
-					filtered = append(filtered, territories[i])
-				}
-			}
-			territories = filtered
-		}
+		territories = filterByName(localeID, territories, *name, func(x *models.TerritoryName) string { return x.Name })
 	}
 
 	return &territories, nil
 }
 
+// IsTerritoryAvailable method to check if a territory is available.
+func IsTerritoryAvailable(territoryID string) (bool, error) {
+	if result := database.Pg.Limit(1).Find(&models.Territory{}, "id = ?", territoryID); result.Error != nil {
+		return false, result.Error
+	} else {
+		return result.RowsAffected == 1, nil
+	}
+}
+
+// ConvertTerritoryCode method to find the territory of an alpha-2, alpha-3 or numeric code, e.g. NL, NLD or 528.
+// Deprecated codes like BU or 104 are mapped to their current territory with the CLDR territory aliases; the alias
+// is returned when it was used. Territories without code mapping are returned without alpha-3 and numeric code.
+// Returns an empty territory code when the code is unknown.
+func ConvertTerritoryCode(code string) (*models.TerritoryCode, *models.LocaleAlias, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code != "" && strings.Trim(code, "0123456789") == "" && len(code) < 3 {
+		code = strings.Repeat("0", 3-len(code)) + code
+	}
+
+	territoryCode := &models.TerritoryCode{}
+	if result := database.Pg.
+		Preload("Territory").
+		Where("territory_id = ? OR alpha3 = ? OR numeric = ?", code, code, code).
+		Order(gorm.Expr("territory_id = ? DESC", code)).
+		Limit(1).
+		Find(territoryCode); result.Error != nil {
+		return nil, nil, result.Error
+	} else if territoryCode.TerritoryID != "" {
+		return territoryCode, nil, nil
+	}
+
+	// Territories without alpha-3 or numeric code, e.g. EZ, only have their own ID.
+	if territoryCode, err := getUncodedTerritory(code); err != nil {
+		return nil, nil, err
+	} else if territoryCode.TerritoryID != "" {
+		return territoryCode, nil, nil
+	}
+
+	alias := &models.LocaleAlias{}
+	if result := database.Pg.Limit(1).Find(alias, "type = ? AND alias = ?", enums.ALIAS_TERRITORY, code); result.Error != nil {
+		return nil, nil, result.Error
+	} else if alias.Alias == "" {
+		return territoryCode, nil, nil
+	}
+
+	// The first replacement is the default of a territory that was split, e.g. SU.
+	replacement, _, _ := strings.Cut(alias.Replacement, " ")
+	if result := database.Pg.Preload("Territory").Limit(1).Find(territoryCode, "territory_id = ?", replacement); result.Error != nil {
+		return nil, nil, result.Error
+	} else if territoryCode.TerritoryID != "" {
+		return territoryCode, alias, nil
+	}
+
+	territoryCode, err := getUncodedTerritory(replacement)
+	if err != nil {
+		return nil, nil, err
+	} else if territoryCode.TerritoryID == "" {
+		return territoryCode, nil, nil
+	}
+
+	return territoryCode, alias, nil
+}
+
+// getUncodedTerritory returns a territory without code mapping as territory code without alpha-3 and numeric code.
+// Returns an empty territory code when the territory doesn't exist.
+func getUncodedTerritory(territoryID string) (*models.TerritoryCode, error) {
+	territory := &models.Territory{}
+	if result := database.Pg.Limit(1).Find(territory, "id = ?", territoryID); result.Error != nil {
+		return nil, result.Error
+	} else if territory.ID == "" {
+		return &models.TerritoryCode{}, nil
+	}
+
+	return &models.TerritoryCode{TerritoryID: territory.ID, Territory: *territory}, nil
+}
+
+// GetTerritoryInfo method to get the supplemental info of a territory with its languages, most spoken first,
+// and the currencies that are currently legal tender, primary currency first.
+func GetTerritoryInfo(territoryID string) (*models.TerritoryInfo, error) {
+	territoryInfo := &models.TerritoryInfo{}
+
+	if result := database.Pg.
+		Preload("Territory").
+		Preload("Languages", func(db *gorm.DB) *gorm.DB {
+			return db.Order("territory_languages.population_percent DESC, territory_languages.language_id")
+		}).
+		Preload("Currencies", func(db *gorm.DB) *gorm.DB {
+			return db.Where(`territory_currencies."to" IS NULL AND territory_currencies.tender = ?`, true).Order("territory_currencies.priority")
+		}).
+		Find(territoryInfo, "territory_id = ?", territoryID); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return territoryInfo, nil
+}
+
+// GetTerritoryChildren method to get the territories a region directly contains, with their names in a locale.
+// The territories are sorted by name with the collation of the locale.
+func GetTerritoryChildren(localeID, territoryID string) (*[]models.TerritoryName, error) {
+	territories := make([]models.TerritoryName, 0)
+
+	if result := database.Pg.Model(&models.TerritoryName{}).
+		Preload("Territory").
+		Preload("Code").
+		Joins("JOIN territory_containments ON territory_containments.child_id = territory_names.territory_id").
+		Where("territory_containments.parent_id = ? AND territory_names.locale_id = ?", territoryID, localeID).
+		Find(&territories); result.Error != nil {
+		return nil, result.Error
+	}
+
+	sortByCollation(localeID, territories, func(t *models.TerritoryName) string { return t.Name })
+
+	return &territories, nil
+}
+
+// territoryAncestor is a region that contains a territory, at the depth of its nearest containment.
+type territoryAncestor struct {
+	ID    string
+	Depth int
+}
+
+// GetTerritoryAncestors method to get the regions and groupings that contain a territory, with their names in a locale.
+// The ancestors are ordered from the nearest region up to the world (001); ancestors at the same depth
+// are sorted by name with the collation of the locale.
+func GetTerritoryAncestors(localeID, territoryID string) (*[]models.TerritoryName, error) {
+	ancestors := make([]territoryAncestor, 0)
+	if result := database.Pg.Raw(`
+		WITH RECURSIVE ancestors(id, depth) AS (
+			SELECT parent_id, 1 FROM territory_containments WHERE child_id = ?
+			UNION ALL
+			SELECT territory_containments.parent_id, ancestors.depth + 1 FROM territory_containments JOIN ancestors ON territory_containments.child_id = ancestors.id
+		)
+		SELECT id, MIN(depth) AS depth FROM ancestors GROUP BY id`, territoryID).
+		Scan(&ancestors); result.Error != nil {
+		return nil, result.Error
+	}
+
+	territories := make([]models.TerritoryName, 0)
+	if len(ancestors) == 0 {
+		return &territories, nil
+	}
+
+	depths := make(map[string]int, len(ancestors))
+	ancestorIDs := make([]string, len(ancestors))
+	for i := range ancestors {
+		depths[ancestors[i].ID] = ancestors[i].Depth
+		ancestorIDs[i] = ancestors[i].ID
+	}
+
+	if result := database.Pg.
+		Preload("Territory").
+		Preload("Code").
+		Find(&territories, "territory_id IN ? AND locale_id = ?", ancestorIDs, localeID); result.Error != nil {
+		return nil, result.Error
+	}
+
+	sortByCollation(localeID, territories, func(t *models.TerritoryName) string { return t.Name })
+	slices.SortStableFunc(territories, func(a, b models.TerritoryName) int {
+		return depths[a.TerritoryID] - depths[b.TerritoryID]
+	})
+
+	return &territories, nil
+}
+
 // isTerritoriesLookupInCache checks if the territories exists in the cache.
-func isTerritoriesLookupInCache(localeID string, t *enums.TerritoryType) (bool, error) {
-	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Exists().Key(territoryLookupCacheKey(localeID, t)).Build())
+func isTerritoriesLookupInCache(localeID string, t *enums.TerritoryType, containedIn *string) (bool, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Exists().Key(territoryLookupCacheKey(localeID, t, containedIn)).Build())
 	if result.Error() != nil {
 		return false, result.Error()
 	}
@@ -79,8 +247,8 @@ func isTerritoriesLookupInCache(localeID string, t *enums.TerritoryType) (bool,
 }
 
 // getTerritoriesLookupFromCache gets the territories from the cache.
-func getTerritoriesLookupFromCache(localeID string, t *enums.TerritoryType) (*[]models.TerritoryName, error) {
-	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Get().Key(territoryLookupCacheKey(localeID, t)).Build())
+func getTerritoriesLookupFromCache(localeID string, t *enums.TerritoryType, containedIn *string) (*[]models.TerritoryName, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Get().Key(territoryLookupCacheKey(localeID, t, containedIn)).Build())
 	if result.Error() != nil {
 		return nil, result.Error()
 	}
@@ -99,7 +267,7 @@ func getTerritoriesLookupFromCache(localeID string, t *enums.TerritoryType) (*[]
 }
 
 // setTerritoriesLookupToCache sets the territories to the cache.
-func setTerritoriesLookupToCache(localeID string, t *enums.TerritoryType, territories *[]models.TerritoryName) error {
+func setTerritoriesLookupToCache(localeID string, t *enums.TerritoryType, containedIn *string, territories *[]models.TerritoryName) error {
 	value, err := json.Marshal(territories)
 	if err != nil {
 		return err
@@ -111,7 +279,7 @@ func setTerritoriesLookupToCache(localeID string, t *enums.TerritoryType, territ
 		return err
 	}
 
-	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Set().Key(territoryLookupCacheKey(localeID, t)).Value(valkey.BinaryString(value)).Ex(duration).Build())
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Set().Key(territoryLookupCacheKey(localeID, t, containedIn)).Value(valkey.BinaryString(value)).Ex(duration).Build())
 	if result.Error() != nil {
 		return result.Error()
 	}
@@ -120,11 +288,16 @@ func setTerritoriesLookupToCache(localeID string, t *enums.TerritoryType, territ
 }
 
 // territoryLookupCacheKey returns the key for the territories cache.
-func territoryLookupCacheKey(localeID string, t *enums.TerritoryType) string {
+func territoryLookupCacheKey(localeID string, t *enums.TerritoryType, containedIn *string) string {
 	tt := "all"
 	if t != nil {
 		tt = t.String()
 	}
 
-	return fmt.Sprintf("territories:lookup:%s:%s", localeID, tt)
+	parent := "all"
+	if containedIn != nil {
+		parent = *containedIn
+	}
+
+	return fmt.Sprintf("territories:lookup:%s:%s:%s", localeID, tt, parent)
 }
@@ -0,0 +1,124 @@
+package services
+
+import (
+	"api-i18n/main/src/cache"
+	"api-i18n/main/src/database"
+	"api-i18n/main/src/models"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+)
+
+// GetTimeZonesLookup method to get time zones lookup by locale ID, optional territory and optional name filter.
+// The name filter matches the generic name, the exemplar city and the IANA ID.
+func GetTimeZonesLookup(localeID string, territoryID *string, name *string) (*[]models.TimeZoneName, error) {
+	timeZones := make([]models.TimeZoneName, 0)
+
+	if inCache, err := isTimeZonesLookupInCache(localeID, territoryID); err != nil {
+		return nil, err
+	} else if inCache {
+		if cacheTimeZones, err := getTimeZonesLookupFromCache(localeID, territoryID); err != nil {
+			return nil, err
+		} else if cacheTimeZones != nil && len(*cacheTimeZones) > 0 {
+			timeZones = *cacheTimeZones
+		}
+	}
+
+	if len(timeZones) == 0 {
+		query := database.Pg.Model(&models.TimeZoneName{}).
+			Preload("TimeZone").
+			Joins("JOIN time_zones ON time_zone_names.time_zone_id = time_zones.id").
+			Order("time_zone_names.exemplar_city")
+
+		if territoryID != nil {
+			query = query.Where("time_zones.territory_id = ?", *territoryID)
+		}
+
+		if result := query.Find(&timeZones, "locale_id = ?", localeID); result.Error != nil {
+			return nil, result.Error
+		}
+
+		_ = setTimeZonesLookupToCache(localeID, territoryID, &timeZones)
+	}
+
+	// If a name filter is provided, match it ignoring case and accents against the name, exemplar city and ID,
+	// names starting with it first
+	if name != nil {
+		timeZones = filterByName(localeID, timeZones, *name,
+			func(x *models.TimeZoneName) string { return x.GenericName },
+			func(x *models.TimeZoneName) string { return x.ExemplarCity },
+			func(x *models.TimeZoneName) string { return x.TimeZoneID })
+	}
+
+	return &timeZones, nil
+}
+
+// isTimeZonesLookupInCache checks if the time zones exists in the cache.
+func isTimeZonesLookupInCache(localeID string, territoryID *string) (bool, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Exists().Key(timeZoneLookupCacheKey(localeID, territoryID)).Build())
+	if result.Error() != nil {
+		return false, result.Error()
+	}
+
+	value, err := result.ToInt64()
+	if err != nil {
+		return false, err
+	}
+
+	return value == 1, nil
+}
+
+// getTimeZonesLookupFromCache gets the time zones from the cache.
+func getTimeZonesLookupFromCache(localeID string, territoryID *string) (*[]models.TimeZoneName, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Get().Key(timeZoneLookupCacheKey(localeID, territoryID)).Build())
+	if result.Error() != nil {
+		return nil, result.Error()
+	}
+
+	value, err := result.ToString()
+	if err != nil {
+		return nil, err
+	}
+
+	var timeZones []models.TimeZoneName
+	if err := json.Unmarshal([]byte(value), &timeZones); err != nil {
+		return nil, err
+	}
+
+	return &timeZones, nil
+}
+
+// setTimeZonesLookupToCache sets the time zones to the cache.
+func setTimeZonesLookupToCache(localeID string, territoryID *string, timeZones *[]models.TimeZoneName) error {
+	value, err := json.Marshal(timeZones)
+	if err != nil {
+		return err
+	}
+
+	expiration := os.Getenv("VALKEY_EXPIRATION")
+	duration, err := time.ParseDuration(expiration)
+	if err != nil {
+		return err
+	}
+
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Set().Key(timeZoneLookupCacheKey(localeID, territoryID)).Value(valkey.BinaryString(value)).Ex(duration).Build())
+	if result.Error() != nil {
+		return result.Error()
+	}
+
+	return nil
+}
+
+// timeZoneLookupCacheKey returns the key for the time zones cache.
+func timeZoneLookupCacheKey(localeID string, territoryID *string) string {
+	territory := "all"
+	if territoryID != nil {
+		territory = *territoryID
+	}
+
+	return fmt.Sprintf("timezones:lookup:%s:%s", localeID, territory)
+}
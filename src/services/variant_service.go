@@ -0,0 +1,108 @@
+package services
+
+import (
+	"api-i18n/main/src/cache"
+	"api-i18n/main/src/database"
+	"api-i18n/main/src/models"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+)
+
+// GetVariantsLookup method to get variants lookup by locale ID and optional name filter.
+func GetVariantsLookup(localeID string, name *string) (*[]models.VariantName, error) {
+	variants := make([]models.VariantName, 0)
+
+	if inCache, err := isVariantsLookupInCache(localeID); err != nil {
+		return nil, err
+	} else if inCache {
+		if cacheVariants, err := getVariantsLookupFromCache(localeID); err != nil {
+			return nil, err
+		} else if cacheVariants != nil && len(*cacheVariants) > 0 {
+			variants = *cacheVariants
+		}
+	}
+
+	if len(variants) == 0 {
+		query := database.Pg.Model(&models.VariantName{}).
+			Where("variant_names.variant_id NOT LIKE ?", "%-alt-%")
+
+		if result := query.Find(&variants, "locale_id = ?", localeID); result.Error != nil {
+			return nil, result.Error
+		}
+
+		_ = setVariantsLookupToCache(localeID, &variants)
+	}
+
+	// If a name filter is provided, match it ignoring case and accents, names starting with it first
+	if name != nil {
+		variants = filterByName(localeID, variants, *name, func(x *models.VariantName) string { return x.Name })
+	}
+
+	return &variants, nil
+}
+
+// isVariantsLookupInCache checks if the variants exists in the cache.
+func isVariantsLookupInCache(localeID string) (bool, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Exists().Key(variantLookupCacheKey(localeID)).Build())
+	if result.Error() != nil {
+		return false, result.Error()
+	}
+
+	value, err := result.ToInt64()
+	if err != nil {
+		return false, err
+	}
+
+	return value == 1, nil
+}
+
+// getVariantsLookupFromCache gets the variants from the cache.
+func getVariantsLookupFromCache(localeID string) (*[]models.VariantName, error) {
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Get().Key(variantLookupCacheKey(localeID)).Build())
+	if result.Error() != nil {
+		return nil, result.Error()
+	}
+
+	value, err := result.ToString()
+	if err != nil {
+		return nil, err
+	}
+
+	var variants []models.VariantName
+	if err := json.Unmarshal([]byte(value), &variants); err != nil {
+		return nil, err
+	}
+
+	return &variants, nil
+}
+
+// setVariantsLookupToCache sets the variants to the cache.
+func setVariantsLookupToCache(localeID string, variants *[]models.VariantName) error {
+	value, err := json.Marshal(variants)
+	if err != nil {
+		return err
+	}
+
+	expiration := os.Getenv("VALKEY_EXPIRATION")
+	duration, err := time.ParseDuration(expiration)
+	if err != nil {
+		return err
+	}
+
+	result := cache.Valkey.Do(context.Background(), cache.Valkey.B().Set().Key(variantLookupCacheKey(localeID)).Value(valkey.BinaryString(value)).Ex(duration).Build())
+	if result.Error() != nil {
+		return result.Error()
+	}
+
+	return nil
+}
+
+// variantLookupCacheKey returns the key for the variants cache.
+func variantLookupCacheKey(localeID string) string {
+	return fmt.Sprintf("variants:lookup:%s", localeID)
+}
@@ -0,0 +1,139 @@
+package services
+
+import (
+	"api-i18n/main/src/database"
+	"api-i18n/main/src/dto/requests"
+	"api-i18n/main/src/enums"
+	"api-i18n/main/src/models"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+)
+
+// webhookClient is the HTTP client used to deliver webhook events.
+var webhookClient = &http.Client{Timeout: 10 * time.Second}
+
+// webhookPayload is the JSON body posted to a webhook.
+type webhookPayload struct {
+	Event     enums.WebhookEvent `json:"event"`
+	AppName   string             `json:"appName"`
+	CreatedAt time.Time          `json:"createdAt"`
+	Data      interface{}        `json:"data"`
+}
+
+// IsWebhookEvent checks if the given event can be subscribed to.
+func IsWebhookEvent(event string) bool {
+	return slices.Contains(enums.WebhookEvents, enums.WebhookEvent(event))
+}
+
+// GetWebhooks method to get the webhooks of an app.
+func GetWebhooks(appName string) (*[]models.Webhook, error) {
+	webhooks := make([]models.Webhook, 0)
+
+	if result := database.Pg.Order("id").Find(&webhooks, "app_name = ?", appName); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &webhooks, nil
+}
+
+// GetWebhookByID method to get a webhook by ID.
+func GetWebhookByID(webhookID uint) (*models.Webhook, error) {
+	webhook := &models.Webhook{}
+
+	if result := database.Pg.Find(webhook, "id = ?", webhookID); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return webhook, nil
+}
+
+// CreateWebhook method to create a webhook.
+func CreateWebhook(webhookDto requests.CreateWebhook) (*models.Webhook, error) {
+	webhook := &models.Webhook{
+		AppName: webhookDto.AppName,
+		URL:     webhookDto.URL,
+		Events:  strings.Join(webhookDto.Events, ","),
+	}
+	if webhookDto.Secret != nil && *webhookDto.Secret != "" {
+		webhook.Secret = sql.NullString{String: *webhookDto.Secret, Valid: true}
+	}
+
+	if result := database.Pg.Create(webhook); result.Error != nil {
+		return nil, result.Error
+	}
+
+	return webhook, nil
+}
+
+// DeleteWebhook method to delete a webhook.
+func DeleteWebhook(webhookID uint) error {
+	return database.Pg.Delete(&models.Webhook{}, webhookID).Error
+}
+
+// DispatchWebhookEvent method to deliver an event to all webhooks of an app that subscribed to it.
+// Events are delivered in the background; failed deliveries are logged and not retried.
+// When the webhook has a secret, the body is signed with HMAC-SHA256 in the X-Webhook-Signature header.
+func DispatchWebhookEvent(appName string, event enums.WebhookEvent, data interface{}) {
+	webhooks, err := GetWebhooks(appName)
+	if err != nil {
+		log.Errorf("Failed to get webhooks of app %s: %v", appName, err)
+		return
+	}
+
+	subscribed := make([]models.Webhook, 0)
+	for _, webhook := range *webhooks {
+		if slices.Contains(strings.Split(webhook.Events, ","), event.String()) {
+			subscribed = append(subscribed, webhook)
+		}
+	}
+	if len(subscribed) == 0 {
+		return
+	}
+
+	body, err := json.Marshal(webhookPayload{Event: event, AppName: appName, CreatedAt: time.Now(), Data: data})
+	if err != nil {
+		log.Errorf("Failed to encode webhook event %s: %v", event, err)
+		return
+	}
+
+	for _, webhook := range subscribed {
+		go deliverWebhook(webhook, event, body)
+	}
+}
+
+// deliverWebhook posts an encoded event to a webhook.
+func deliverWebhook(webhook models.Webhook, event enums.WebhookEvent, body []byte) {
+	request, err := http.NewRequest(http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		log.Errorf("Failed to create request for webhook %d: %v", webhook.ID, err)
+		return
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Webhook-Event", event.String())
+	if webhook.Secret.Valid {
+		mac := hmac.New(sha256.New, []byte(webhook.Secret.String))
+		mac.Write(body)
+		request.Header.Set("X-Webhook-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	response, err := webhookClient.Do(request)
+	if err != nil {
+		log.Errorf("Failed to deliver %s to webhook %d: %v", event, webhook.ID, err)
+		return
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusBadRequest {
+		log.Errorf("Webhook %d responded to %s with status %d", webhook.ID, event, response.StatusCode)
+	}
+}
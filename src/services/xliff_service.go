@@ -0,0 +1,137 @@
+package services
+
+import (
+	"api-i18n/main/src/database"
+	"api-i18n/main/src/models"
+	"api-i18n/main/src/storage"
+	"encoding/xml"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+// xliffDocument is an XLIFF 1.2 document.
+type xliffDocument struct {
+	XMLName xml.Name  `xml:"urn:oasis:names:tc:xliff:document:1.2 xliff"`
+	Version string    `xml:"version,attr"`
+	File    xliffFile `xml:"file"`
+}
+
+type xliffFile struct {
+	Original       string           `xml:"original,attr"`
+	DataType       string           `xml:"datatype,attr"`
+	SourceLanguage string           `xml:"source-language,attr"`
+	TargetLanguage string           `xml:"target-language,attr"`
+	Units          []xliffTransUnit `xml:"body>trans-unit"`
+}
+
+type xliffTransUnit struct {
+	ID            string              `xml:"id,attr"`
+	ResName       string              `xml:"resname,attr"`
+	MaxWidth      string              `xml:"maxwidth,attr,omitempty"`
+	SizeUnit      string              `xml:"size-unit,attr,omitempty"`
+	Source        string              `xml:"source"`
+	Target        *xliffTarget        `xml:"target,omitempty"`
+	Notes         []string            `xml:"note,omitempty"`
+	ContextGroups []xliffContextGroup `xml:"context-group,omitempty"`
+}
+
+type xliffTarget struct {
+	State string `xml:"state,attr,omitempty"`
+	Value string `xml:",chardata"`
+}
+
+type xliffContextGroup struct {
+	Purpose  string         `xml:"purpose,attr"`
+	Contexts []xliffContext `xml:"context"`
+}
+
+type xliffContext struct {
+	Type  string `xml:"context-type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// ExportXLIFF method to export the keys of an app as an XLIFF 1.2 document from the source to the target locale.
+// Key descriptions are exported as notes and screenshot URLs as "x-screenshot" contexts.
+// The length limit of the target locale is exported as maxwidth in characters (or glyphs, for grapheme limits);
+// line limits are exported as note.
+func ExportXLIFF(appName, sourceLocaleID, targetLocaleID string) ([]byte, error) {
+	keys := make([]models.Key, 0)
+
+	if result := database.Pg.
+		Scopes(scopeExcludeDeletedCategory).
+		Preload("Category").
+		Preload("Translations", "locale_id IN ?", []string{sourceLocaleID, targetLocaleID}).
+		Preload("LengthLimits").
+		Preload("Screenshots", func(db *gorm.DB) *gorm.DB { return db.Order("key_screenshots.id") }).
+		Order("keys.id").
+		Find(&keys, "keys.app_name = ?", appName); result.Error != nil {
+		return nil, result.Error
+	}
+
+	document := xliffDocument{
+		Version: "1.2",
+		File: xliffFile{
+			Original:       appName,
+			DataType:       "plaintext",
+			SourceLanguage: sourceLocaleID,
+			TargetLanguage: targetLocaleID,
+			Units:          make([]xliffTransUnit, 0, len(keys)),
+		},
+	}
+
+	for i := range keys {
+		key := &keys[i]
+
+		unit := xliffTransUnit{
+			ID:      strconv.FormatUint(uint64(key.ID), 10),
+			ResName: key.Name,
+		}
+		if key.Category != nil {
+			unit.ResName = key.Category.Name + "." + key.Name
+		}
+
+		for j := range key.Translations {
+			switch key.Translations[j].LocaleID {
+			case sourceLocaleID:
+				unit.Source = key.Translations[j].Value
+			case targetLocaleID:
+				unit.Target = &xliffTarget{State: "translated", Value: key.Translations[j].Value}
+			}
+		}
+		if sourceLocaleID == targetLocaleID && unit.Target == nil {
+			unit.Target = &xliffTarget{State: "final", Value: unit.Source}
+		}
+
+		if key.Description.Valid {
+			unit.Notes = append(unit.Notes, key.Description.String)
+		}
+
+		maxCharacters, maxGraphemes, maxLines := KeyLengthLimitsFor(key, targetLocaleID)
+		if maxCharacters.Valid {
+			unit.MaxWidth, unit.SizeUnit = strconv.Itoa(maxCharacters.V), "char"
+		} else if maxGraphemes.Valid {
+			unit.MaxWidth, unit.SizeUnit = strconv.Itoa(maxGraphemes.V), "glyph"
+		}
+		if maxLines.Valid {
+			unit.Notes = append(unit.Notes, "Maximum lines: "+strconv.Itoa(maxLines.V))
+		}
+
+		if len(key.Screenshots) > 0 {
+			group := xliffContextGroup{Purpose: "information"}
+			for j := range key.Screenshots {
+				group.Contexts = append(group.Contexts, xliffContext{Type: "x-screenshot", Value: storage.URL(key.Screenshots[j].Path)})
+			}
+			unit.ContextGroups = append(unit.ContextGroups, group)
+		}
+
+		document.File.Units = append(document.File.Units, unit)
+	}
+
+	value, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), value...), nil
+}
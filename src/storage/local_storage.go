@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalStoragePublicURL is the route under which the local storage files are served.
+const LocalStoragePublicURL = "/v1/files"
+
+// LocalStorage stores files on the local filesystem.
+type LocalStorage struct {
+	root      string
+	publicURL string
+}
+
+// NewLocalStorage creates a local storage in the given root directory.
+// Files are served under publicURL, which defaults to LocalStoragePublicURL.
+func NewLocalStorage(root, publicURL string) (*LocalStorage, error) {
+	if root == "" {
+		root = "storage"
+	}
+	if publicURL == "" {
+		publicURL = LocalStoragePublicURL
+	}
+
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &LocalStorage{root: root, publicURL: strings.TrimRight(publicURL, "/")}, nil
+}
+
+// Root returns the root directory of the local storage.
+func (s *LocalStorage) Root() string {
+	return s.root
+}
+
+// Put stores the content of reader under the given path.
+func (s *LocalStorage) Put(_ context.Context, path string, reader io.Reader, _ int64, _ string) error {
+	fullPath, err := s.fullPath(path)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+		return err
+	}
+
+	file, err := os.Create(fullPath)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(file, reader); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// Delete removes the file with the given path.
+func (s *LocalStorage) Delete(_ context.Context, path string) error {
+	fullPath, err := s.fullPath(path)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(fullPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// URL returns the public URL of the file with the given path.
+func (s *LocalStorage) URL(path string) string {
+	return s.publicURL + "/" + strings.TrimLeft(filepath.ToSlash(path), "/")
+}
+
+// fullPath returns the filesystem path of a storage path and prevents escaping the root directory.
+func (s *LocalStorage) fullPath(path string) (string, error) {
+	fullPath := filepath.Join(s.root, filepath.FromSlash(path))
+	if rel, err := filepath.Rel(s.root, fullPath); err != nil || strings.HasPrefix(rel, "..") {
+		return "", errors.New("invalid storage path")
+	}
+
+	return fullPath, nil
+}
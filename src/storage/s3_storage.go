@@ -0,0 +1,77 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Storage stores files in a bucket of an S3-compatible object storage like AWS S3 or MinIO.
+type S3Storage struct {
+	client        *minio.Client
+	bucket        string
+	publicURL     string
+	urlExpiration time.Duration
+}
+
+// NewS3Storage creates an S3 storage and creates the bucket when it does not exist.
+// Files are linked through presigned URLs that expire after urlExpiration. With publicURL, e.g. a CDN or
+// a public-read bucket, files are linked through it without signature instead.
+func NewS3Storage(endpoint, region, bucket, accessKey, secretKey string, useSSL bool, publicURL string, urlExpiration time.Duration) (*S3Storage, error) {
+	if endpoint == "" || bucket == "" {
+		return nil, errors.New("S3_ENDPOINT and S3_BUCKET are required for the s3 storage driver")
+	}
+
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure: useSSL,
+		Region: region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	if exists, err := client.BucketExists(ctx, bucket); err != nil {
+		return nil, err
+	} else if !exists {
+		if err := client.MakeBucket(ctx, bucket, minio.MakeBucketOptions{Region: region}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &S3Storage{client: client, bucket: bucket, publicURL: strings.TrimRight(publicURL, "/"), urlExpiration: urlExpiration}, nil
+}
+
+// Put stores the content of reader under the given path.
+func (s *S3Storage) Put(ctx context.Context, path string, reader io.Reader, size int64, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, path, reader, size, minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
+// Delete removes the file with the given path.
+func (s *S3Storage) Delete(ctx context.Context, path string) error {
+	return s.client.RemoveObject(ctx, s.bucket, path, minio.RemoveObjectOptions{})
+}
+
+// URL returns a presigned URL of the file with the given path, or its public URL when configured.
+// When signing fails, an empty string is returned.
+func (s *S3Storage) URL(path string) string {
+	if s.publicURL != "" {
+		return s.publicURL + "/" + strings.TrimLeft(path, "/")
+	}
+
+	presignedURL, err := s.client.PresignedGetObject(context.Background(), s.bucket, path, s.urlExpiration, nil)
+	if err != nil {
+		log.Errorf("Failed to presign the URL of %s: %v", path, err)
+		return ""
+	}
+
+	return presignedURL.String()
+}
@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// defaultURLExpiration is how long presigned S3 URLs are valid without S3_URL_EXPIRATION.
+const defaultURLExpiration = time.Hour
+
+// Storage stores files like key screenshots.
+type Storage interface {
+	// Put stores the content of reader under the given path.
+	Put(ctx context.Context, path string, reader io.Reader, size int64, contentType string) error
+	// Delete removes the file with the given path.
+	Delete(ctx context.Context, path string) error
+	// URL returns the URL of the file with the given path, which may expire.
+	URL(path string) string
+}
+
+var Files Storage
+
+// OpenStorage opens the file storage configured with STORAGE_DRIVER.
+// Supported drivers are "local" (default) and "s3".
+func OpenStorage() error {
+	var err error
+
+	switch driver := os.Getenv("STORAGE_DRIVER"); driver {
+	case "", "local":
+		Files, err = NewLocalStorage(os.Getenv("STORAGE_LOCAL_PATH"), os.Getenv("STORAGE_PUBLIC_URL"))
+	case "s3":
+		urlExpiration := defaultURLExpiration
+		if expiration := os.Getenv("S3_URL_EXPIRATION"); expiration != "" {
+			if urlExpiration, err = time.ParseDuration(expiration); err != nil {
+				return err
+			}
+		}
+
+		Files, err = NewS3Storage(
+			os.Getenv("S3_ENDPOINT"),
+			os.Getenv("S3_REGION"),
+			os.Getenv("S3_BUCKET"),
+			os.Getenv("S3_ACCESS_KEY"),
+			os.Getenv("S3_SECRET_KEY"),
+			os.Getenv("S3_USE_SSL") == "true",
+			os.Getenv("STORAGE_PUBLIC_URL"),
+			urlExpiration,
+		)
+	default:
+		err = fmt.Errorf("unknown storage driver %q", driver)
+	}
+
+	return err
+}
+
+// URL returns the URL of the file with the given path in the opened storage,
+// or an empty string when no storage is opened.
+func URL(path string) string {
+	if Files == nil {
+		return ""
+	}
+
+	return Files.URL(path)
+}
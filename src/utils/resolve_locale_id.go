@@ -5,42 +5,39 @@ import (
 	"strings"
 )
 
-// ResolveLocaleId attempts to resolve a localeID by checking availability and
-// progressively stripping trailing segments separated by '-' until a match is found.
+// ResolveLocaleId attempts to resolve a localeID to an available locale. The locale ID is case-insensitive,
+// may use - or _ separators, and deprecated subtags are replaced with their CLDR aliases. The candidates are
+// the locale itself, its likely full form, its shortest form and their fallbacks; they are checked in one query.
 // Examples:
 //
 //	en -> en
-//	en-ER -> en-ER, fallback to en if en-ER not available
-//	en-polyton -> en-polyton, fallback to en if en-polyton not available
+//	EN_us -> en-US, fallback to en if en-US not available
+//	zh-TW -> zh-Hant-TW
+//	iw -> he
+//	sr-RS -> sr-Cyrl-RS, fallback to sr-Cyrl, then sr
 //	az-Arab-IQ -> az-Arab-IQ, fallback to az-Arab, then az
 //
-// Returns a pointer to the resolved locale ID or nil if none are available.
-func ResolveLocaleId(localeID string) *string {
-	if localeID == "" {
-		return nil
+// Returns a pointer to the resolved locale ID as stored, e.g. en-US-POSIX, or nil if none are available.
+// An error is returned when the available locales can't be queried.
+func ResolveLocaleId(localeID string) (*string, error) {
+	candidates := services.GetLocaleCandidates(localeID)
+	if len(candidates) == 0 {
+		return nil, nil
 	}
 
-	// First, try the provided localeID as-is.
-	if ok, err := services.IsLocaleAvailable(localeID); err == nil && ok {
-		return &localeID
+	available, err := services.GetAvailableLocaleIDs(candidates)
+	if err != nil {
+		return nil, err
 	}
 
-	// Split into segments and iteratively remove the last segment.
-	parts := strings.Split(localeID, "-")
-	if len(parts) == 0 {
-		return nil
-	}
-
-	// Iteratively try with fewer trailing segments: language[-script[-territory|variant]]
-	for i := len(parts) - 1; i >= 1; i-- {
-		candidate := strings.Join(parts[:i], "-")
-		if ok, err := services.IsLocaleAvailable(candidate); err == nil && ok {
-			// Return a pointer to a new string variable to avoid referencing the loop variable.
-			resolved := candidate
-			return &resolved
+	for _, candidate := range candidates {
+		for i := range available {
+			if strings.EqualFold(available[i], candidate) {
+				return &available[i], nil
+			}
 		}
 	}
 
 	// No match found.
-	return nil
+	return nil, nil
 }